@@ -28,12 +28,21 @@ const (
 	ErrCodeNotFound AppErrorCode = "ERR_NOT_FOUND"
 	ErrCodeConflict AppErrorCode = "ERR_CONFLICT"
 
+	// Common Errors - User
+	ErrCodeUserBlocked AppErrorCode = "ERR_USER_BLOCKED"
+
 	// Common Errors - Chat
 	ErrCodeClientRegistrationFailed   AppErrorCode = "ERR_CLIENT_REGISTRATION_FAILED"
 	ErrCodeClientUnregistrationFailed AppErrorCode = "ERR_CLIENT_UNREGISTRATION_FAILED"
 	ErrCodeMessageEncodingFailed      AppErrorCode = "ERR_MESSAGE_ENCODING_FAILED"
 	ErrCodeRoomCreationFailed         AppErrorCode = "ERR_ROOM_CREATION_FAILED"
 	ErrCodeRoomNotFound               AppErrorCode = "ERR_ROOM_NOT_FOUND"
+	ErrCodeNotRoomMember              AppErrorCode = "ERR_NOT_ROOM_MEMBER"
+	ErrCodeInvalidMessage             AppErrorCode = "ERR_INVALID_MESSAGE"
+	ErrCodeInvalidMessageType         AppErrorCode = "ERR_INVALID_MESSAGE_TYPE"
+	ErrCodeMessageTooLong             AppErrorCode = "ERR_MESSAGE_TOO_LONG"
+	ErrCodeMediaNotOwned              AppErrorCode = "ERR_MEDIA_NOT_OWNED"
+	ErrCodeServerBusy                 AppErrorCode = "ERR_SERVER_BUSY"
 
 	ErrCodeUnknown AppErrorCode = "ERR_UNKNOWN"
 )
@@ -112,6 +121,34 @@ func ErrConflict(err error) *AppError {
 	return ErrDefault(err, http.StatusConflict, ErrCodeConflict)
 }
 
+func ErrUserBlocked(err error) *AppError {
+	return ErrDefault(err, http.StatusForbidden, ErrCodeUserBlocked)
+}
+
+func ErrNotRoomMember(err error) *AppError {
+	return ErrDefault(err, http.StatusForbidden, ErrCodeNotRoomMember)
+}
+
+func ErrInvalidMessage(err error) *AppError {
+	return ErrDefault(err, http.StatusBadRequest, ErrCodeInvalidMessage)
+}
+
+func ErrInvalidMessageType(err error) *AppError {
+	return ErrDefault(err, http.StatusBadRequest, ErrCodeInvalidMessageType)
+}
+
+func ErrMessageTooLong(err error) *AppError {
+	return ErrDefault(err, http.StatusBadRequest, ErrCodeMessageTooLong)
+}
+
+func ErrMediaNotOwned(err error) *AppError {
+	return ErrDefault(err, http.StatusForbidden, ErrCodeMediaNotOwned)
+}
+
+func ErrServerBusy(err error) *AppError {
+	return ErrDefault(err, http.StatusServiceUnavailable, ErrCodeServerBusy)
+}
+
 func ErrUnknown(err error) *AppError {
 	return ErrDefault(err, http.StatusInternalServerError, ErrCodeUnknown)
 }
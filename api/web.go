@@ -3,7 +3,11 @@ package pnd
 import (
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -62,6 +66,102 @@ func ParseOptionalIntQuery(c echo.Context, query string) (*int, error) {
 	return &value, nil
 }
 
+func ParseOptionalFloatQuery(c echo.Context, query string) (*float64, error) {
+	queryStr := c.QueryParam(query)
+	if queryStr == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(queryStr, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, ErrInvalidQuery(fmt.Errorf("expected float value for query: %s", query))
+	}
+
+	return &value, nil
+}
+
+func ParseOptionalBoolQuery(c echo.Context, query string) (*bool, error) {
+	queryStr := c.QueryParam(query)
+	if queryStr == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(queryStr)
+	if err != nil {
+		return nil, ErrInvalidQuery(fmt.Errorf("expected boolean value for query: %s", query))
+	}
+
+	return &value, nil
+}
+
+// ParseOptionalDateQuery parses a date query in YYYY-MM-DD format.
+func ParseOptionalDateQuery(c echo.Context, query string) (*time.Time, error) {
+	queryStr := c.QueryParam(query)
+	if queryStr == "" {
+		return nil, nil
+	}
+
+	value, err := time.Parse(time.DateOnly, queryStr)
+	if err != nil {
+		return nil, ErrInvalidQuery(fmt.Errorf("expected date in YYYY-MM-DD format for query: %s", query))
+	}
+
+	return &value, nil
+}
+
+// ParseOptionalTimeOfDayQuery parses a time of day query in HH:MM format.
+func ParseOptionalTimeOfDayQuery(c echo.Context, query string) (*string, error) {
+	queryStr := c.QueryParam(query)
+	if queryStr == "" {
+		return nil, nil
+	}
+
+	const timeOfDayLayout = "15:04"
+	value, err := time.Parse(timeOfDayLayout, queryStr)
+	if err != nil || value.Format(timeOfDayLayout) != queryStr {
+		return nil, ErrInvalidQuery(fmt.Errorf("expected time in HH:MM format for query: %s", query))
+	}
+
+	return &queryStr, nil
+}
+
+// ParseOptionalEnumQuery parses a string query that must be one of the allowed values.
+func ParseOptionalEnumQuery(c echo.Context, query string, allowed ...string) (*string, error) {
+	queryStr := c.QueryParam(query)
+	if queryStr == "" {
+		return nil, nil
+	}
+
+	if !slices.Contains(allowed, queryStr) {
+		return nil, ErrInvalidQuery(
+			fmt.Errorf("expected one of [%s] for query: %s", strings.Join(allowed, ", "), query),
+		)
+	}
+
+	return &queryStr, nil
+}
+
+// ParseOptionalUUIDListQuery parses UUIDs given as comma-separated values or repeated queries.
+func ParseOptionalUUIDListQuery(c echo.Context, query string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, queryStr := range c.QueryParams()[query] {
+		for _, idStr := range strings.Split(queryStr, ",") {
+			idStr = strings.TrimSpace(idStr)
+			if idStr == "" {
+				continue
+			}
+
+			id, err := uuid.Parse(idStr)
+			if err != nil {
+				return nil, ErrInvalidQuery(fmt.Errorf("expected comma-separated UUIDs for query: %s", query))
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
 func ParseRequiredStringQuery(c echo.Context, query string) (*string, error) {
 	queryStr := c.QueryParam(query)
 	if queryStr == "" {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
 	pnd "github.com/pet-sitter/pets-next-door-api/api"
@@ -114,3 +115,18 @@ func (h *AuthHandler) GenerateFBCustomTokenFromKakao(c echo.Context) error {
 		auth.NewGenerateFBCustomTokenResponse(*customToken, userProfile),
 	)
 }
+
+// verifyOptionalAuth 로그인하지 않아도 사용할 수 있는 API에서 로그인한 사용자의 ID를 찾는다.
+// Authorization 헤더가 없으면 유효하지 않은 ID를 반환한다.
+func verifyOptionalAuth(c echo.Context, authService service.AuthService) (uuid.NullUUID, error) {
+	authorization := c.Request().Header.Get("Authorization")
+	if authorization == "" {
+		return uuid.NullUUID{}, nil
+	}
+
+	foundUser, err := authService.VerifyAuthAndGetUser(c.Request().Context(), authorization)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return uuid.NullUUID{UUID: foundUser.ID, Valid: true}, nil
+}
@@ -7,20 +7,24 @@ import (
 	pnd "github.com/pet-sitter/pets-next-door-api/api"
 	domain "github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
 	"github.com/pet-sitter/pets-next-door-api/internal/service"
+	"github.com/pet-sitter/pets-next-door-api/internal/wschat"
 )
 
 type ChatHandler struct {
 	authService service.AuthService
 	chatService service.ChatService
+	wsServer    *wschat.WSServer
 }
 
 func NewChatHandler(
 	authService service.AuthService,
 	chatService service.ChatService,
+	wsServer *wschat.WSServer,
 ) *ChatHandler {
 	return &ChatHandler{
 		authService: authService,
 		chatService: chatService,
+		wsServer:    wsServer,
 	}
 }
 
@@ -62,7 +66,7 @@ func (h ChatHandler) FindRoomByID(c echo.Context) error {
 
 // CreateRoom godoc
 // @Summary 채팅방을 생성합니다.
-// @Description
+// @Description 1:1 채팅방(direct)은 participantIds에 상대방 ID를 전달하며, 이미 있는 경우 기존 채팅방을 반환합니다.
 // @Tags chat
 // @Accept  json
 // @Produce  json
@@ -81,7 +85,25 @@ func (h ChatHandler) CreateRoom(c echo.Context) error {
 	var createRoomRequest domain.CreateRoomRequest
 
 	if bodyError := pnd.ParseBody(c, &createRoomRequest); bodyError != nil {
-		return err
+		return bodyError
+	}
+	if err := createRoomRequest.RoomTypeValidate(); err != nil {
+		return pnd.ErrInvalidBody(err)
+	}
+
+	// 1:1 채팅방은 두 사용자 사이에 하나만 존재하므로, 이미 있으면 기존 채팅방을 반환한다.
+	if createRoomRequest.RoomType == domain.DirectRoomType {
+		res, err := h.chatService.FindOrCreateDirectRoom(
+			c.Request().Context(),
+			user.ID,
+			createRoomRequest.ParticipantIDs[0],
+			createRoomRequest.SOSPostID,
+		)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(http.StatusCreated, res)
 	}
 
 	res, err := h.chatService.CreateRoom(
@@ -97,6 +119,38 @@ func (h ChatHandler) CreateRoom(c echo.Context) error {
 	return c.JSON(http.StatusCreated, res)
 }
 
+// FindDirectRoom godoc
+// @Summary 상대방과의 1:1 채팅방을 조회합니다.
+// @Description
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param userID path string true "상대방 사용자 ID"
+// @Security FirebaseAuth
+// @Success 200 {object} domain.RoomSimpleInfo
+// @Router /chat/rooms/direct/{userID} [get]
+func (h ChatHandler) FindDirectRoom(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	otherUserID, err := pnd.ParseIDFromPath(c, "userID")
+	if err != nil {
+		return err
+	}
+
+	res, err := h.chatService.FindDirectRoom(c.Request().Context(), foundUser.ID, otherUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 // JoinChatRoom godoc
 // @Summary 채팅방에 참가합니다.
 // @Description 채팅방에 참가합니다.
@@ -153,7 +207,161 @@ func (h ChatHandler) LeaveChatRoom(c echo.Context) error {
 		return err
 	}
 
-	res := h.chatService.LeaveRoom(c.Request().Context(), roomID, foundUser.FirebaseUID)
+	if err := h.chatService.LeaveRoom(c.Request().Context(), roomID, foundUser.FirebaseUID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+// UpdateRoom godoc
+// @Summary 채팅방 이름과 이미지를 수정합니다.
+// @Description 방장과 관리자만 수정할 수 있습니다. 전달하지 않은 값은 변경하지 않습니다. removeImage로 이미지를 지울 수 있습니다.
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param roomID path string true "채팅방 ID"
+// @Param request body domain.UpdateRoomRequest true "채팅방 수정 요청"
+// @Security FirebaseAuth
+// @Success 200 {object} domain.RoomSimpleInfo
+// @Router /chat/rooms/{roomID} [put]
+func (h ChatHandler) UpdateRoom(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	roomID, err := pnd.ParseIDFromPath(c, "roomID")
+	if err != nil {
+		return err
+	}
+
+	var updateRoomRequest domain.UpdateRoomRequest
+	if err := pnd.ParseBody(c, &updateRoomRequest); err != nil {
+		return err
+	}
+
+	res, err := h.chatService.UpdateRoom(c.Request().Context(), roomID, foundUser.ID, &updateRoomRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteRoom godoc
+// @Summary 채팅방을 삭제합니다.
+// @Description 방장만 삭제할 수 있으며, 모든 참여자가 채팅방에서 나가게 됩니다.
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param roomID path string true "채팅방 ID"
+// @Security FirebaseAuth
+// @Success 204
+// @Router /chat/rooms/{roomID} [delete]
+func (h ChatHandler) DeleteRoom(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	roomID, err := pnd.ParseIDFromPath(c, "roomID")
+	if err != nil {
+		return err
+	}
+
+	if err := h.chatService.DeleteRoom(c.Request().Context(), roomID, foundUser.ID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// FindRoomMembers godoc
+// @Summary 채팅방 참여자 목록을 조회합니다.
+// @Description 채팅방에 현재 참여중인 사용자의 프로필과 역할을 조회합니다.
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param roomID path string true "채팅방 ID"
+// @Security FirebaseAuth
+// @Success 200 {object} domain.RoomMembersView
+// @Router /chat/rooms/{roomID}/members [get]
+func (h ChatHandler) FindRoomMembers(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	roomID, err := pnd.ParseIDFromPath(c, "roomID")
+	if err != nil {
+		return err
+	}
+
+	res, err := h.chatService.FindRoomMembers(c.Request().Context(), roomID, foundUser.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// UpdateMemberRole godoc
+// @Summary 채팅방 참여자의 역할을 변경합니다.
+// @Description 방장만 참여자를 관리자(admin)로 지정하거나 일반 참여자(member)로 해제할 수 있습니다.
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param roomID path string true "채팅방 ID"
+// @Param userID path string true "참여자 ID"
+// @Param request body domain.UpdateMemberRoleRequest true "역할 변경 요청"
+// @Security FirebaseAuth
+// @Success 200 {object} domain.RoomMembersView
+// @Router /chat/rooms/{roomID}/members/{userID}/role [put]
+func (h ChatHandler) UpdateMemberRole(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	roomID, err := pnd.ParseIDFromPath(c, "roomID")
+	if err != nil {
+		return err
+	}
+
+	targetUserID, err := pnd.ParseIDFromPath(c, "userID")
+	if err != nil {
+		return err
+	}
+
+	var updateMemberRoleRequest domain.UpdateMemberRoleRequest
+	if err := pnd.ParseBody(c, &updateMemberRoleRequest); err != nil {
+		return err
+	}
+
+	res, err := h.chatService.UpdateMemberRole(
+		c.Request().Context(),
+		roomID,
+		foundUser.ID,
+		targetUserID,
+		updateMemberRoleRequest.Role,
+	)
+	if err != nil {
+		return err
+	}
+
 	return c.JSON(http.StatusOK, res)
 }
 
@@ -196,6 +404,14 @@ func (h ChatHandler) FindAllRooms(c echo.Context) error {
 // @Success 200 {object} domain.MessageCursorView
 // @Router /chat/rooms/{roomID}/messages [get]
 func (h ChatHandler) FindMessagesByRoomID(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
 	roomID, err := pnd.ParseIDFromPath(c, "roomID")
 	if err != nil {
 		return err
@@ -209,6 +425,7 @@ func (h ChatHandler) FindMessagesByRoomID(c echo.Context) error {
 	res, err := h.chatService.FindChatRoomMessagesByRoomID(
 		c.Request().Context(),
 		roomID,
+		foundUser.ID,
 		prev,
 		next,
 		int64(limit),
@@ -219,3 +436,148 @@ func (h ChatHandler) FindMessagesByRoomID(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, res)
 }
+
+// SearchMessages godoc
+// @Summary 채팅방의 메시지를 검색합니다.
+// @Description 채팅방 참여자만 검색할 수 있으며, 검색어가 포함된 메시지를 최신 순으로 검색어 주변 내용과 함께 반환합니다.
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param roomID path string true "채팅방 ID"
+// @Param q query string true "검색어"
+// @Param prev query string false "이전 페이지 커서 (이 메시지보다 이전 검색 결과)"
+// @Param next query string false "다음 페이지 커서 (이 메시지보다 이후 검색 결과)"
+// @Param size query int false "페이지 사이즈" default(30)
+// @Security FirebaseAuth
+// @Success 200 {object} domain.MessageSearchView
+// @Router /chat/rooms/{roomID}/messages/search [get]
+func (h ChatHandler) SearchMessages(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	roomID, err := pnd.ParseIDFromPath(c, "roomID")
+	if err != nil {
+		return err
+	}
+
+	keyword, err := pnd.ParseRequiredStringQuery(c, "q")
+	if err != nil {
+		return err
+	}
+
+	prev, next, limit, err := pnd.ParseCursorPaginationQueries(c, 30)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.chatService.SearchMessages(
+		c.Request().Context(),
+		roomID,
+		foundUser.ID,
+		*keyword,
+		prev,
+		next,
+		int64(limit),
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ReadChatRoom godoc
+// @Summary 채팅방의 메시지를 읽음 처리합니다.
+// @Description 마지막으로 읽은 메시지를 갱신하고, 채팅방 참여자에게 읽음 이벤트를 전달합니다.
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param roomID path string true "채팅방 ID"
+// @Param request body domain.ReadRoomRequest true "읽음 처리 요청"
+// @Security FirebaseAuth
+// @Success 200 {object} domain.ReadReceipt
+// @Router /chat/rooms/{roomID}/read [put]
+func (h ChatHandler) ReadChatRoom(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	roomID, err := pnd.ParseIDFromPath(c, "roomID")
+	if err != nil {
+		return err
+	}
+
+	var readRoomRequest domain.ReadRoomRequest
+	if err := pnd.ParseBody(c, &readRoomRequest); err != nil {
+		return err
+	}
+
+	res, err := h.chatService.MarkRoomAsRead(
+		c.Request().Context(),
+		roomID,
+		foundUser.ID,
+		readRoomRequest.MessageID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := h.wsServer.PublishReadReceipt(c.Request().Context(), res); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// FindOnlineMembers godoc
+// @Summary 채팅방 참여자 중 현재 접속 중인 사용자를 조회합니다.
+// @Description
+// @Tags chat
+// @Accept  json
+// @Produce  json
+// @Param roomID path string true "채팅방 ID"
+// @Security FirebaseAuth
+// @Success 200 {object} domain.OnlineMembersView
+// @Router /chat/rooms/{roomID}/online [get]
+func (h ChatHandler) FindOnlineMembers(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	roomID, err := pnd.ParseIDFromPath(c, "roomID")
+	if err != nil {
+		return err
+	}
+
+	// 채팅방에 참여중인 사용자만 조회할 수 있다.
+	if _, err := h.chatService.FindChatRoomByUIDAndRoomID(
+		c.Request().Context(),
+		foundUser.FirebaseUID,
+		roomID,
+	); err != nil {
+		return err
+	}
+
+	memberIDs, err := h.chatService.FindRoomMemberIDs(c.Request().Context(), roomID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, domain.OnlineMembersView{
+		RoomID:  roomID,
+		UserIDs: h.wsServer.OnlineUserIDs(memberIDs),
+	})
+}
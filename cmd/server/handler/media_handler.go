@@ -13,11 +13,13 @@ import (
 )
 
 type MediaHandler struct {
+	authService  service.AuthService
 	mediaService service.MediaService
 }
 
-func NewMediaHandler(mediaService service.MediaService) *MediaHandler {
+func NewMediaHandler(authService service.AuthService, mediaService service.MediaService) *MediaHandler {
 	return &MediaHandler{
+		authService:  authService,
 		mediaService: mediaService,
 	}
 }
@@ -46,10 +48,11 @@ func (h *MediaHandler) FindMediaByID(c echo.Context) error {
 
 // UploadImage godoc
 // @Summary 이미지를 업로드합니다.
-// @Description
+// @Description 로그인한 경우 업로드한 사용자가 기록되며, 채팅 메시지에는 본인이 업로드한 이미지만 첨부할 수 있습니다.
 // @Tags media
 // @Accept  multipart/form-data
 // @Produce  json
+// @Security FirebaseAuth
 // @Param file formData file true "이미지 파일"
 // @Success 201 {object} media.DetailView
 // @Router /media/images [post]
@@ -77,12 +80,19 @@ func (h *MediaHandler) UploadImage(c echo.Context) error {
 		)
 	}
 
-	res, err := h.mediaService.UploadMedia(
-		c.Request().Context(),
-		file,
-		media.TypeImage,
-		fileHeader.Filename,
-	)
+	// 회원가입 전 프로필 이미지 업로드를 위해 인증은 선택 사항이다.
+	uploaderID, err := verifyOptionalAuth(c, h.authService)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	var res *media.DetailView
+	if uploaderID.Valid {
+		res, err = h.mediaService.UploadUserMedia(ctx, uploaderID.UUID, file, media.TypeImage, fileHeader.Filename)
+	} else {
+		res, err = h.mediaService.UploadMedia(ctx, file, media.TypeImage, fileHeader.Filename)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/notification"
+	"github.com/pet-sitter/pets-next-door-api/internal/service"
+)
+
+type NotificationHandler struct {
+	authService         service.AuthService
+	notificationService service.NotificationService
+}
+
+func NewNotificationHandler(
+	authService service.AuthService,
+	notificationService service.NotificationService,
+) *NotificationHandler {
+	return &NotificationHandler{
+		authService:         authService,
+		notificationService: notificationService,
+	}
+}
+
+// RegisterDeviceToken godoc
+// @Summary 푸시 알림을 받을 기기 토큰을 등록합니다.
+// @Description 채팅방에 접속해 있지 않을 때 새 메시지를 푸시 알림으로 받습니다.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security FirebaseAuth
+// @Param request body notification.RegisterDeviceTokenRequest true "기기 토큰 등록 요청"
+// @Success 201 {object} notification.DeviceTokenView
+// @Router /users/me/device-tokens [post]
+func (h *NotificationHandler) RegisterDeviceToken(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	var registerRequest notification.RegisterDeviceTokenRequest
+	if err := pnd.ParseBody(c, &registerRequest); err != nil {
+		return err
+	}
+
+	res, err := h.notificationService.RegisterDeviceToken(c.Request().Context(), foundUser.ID, &registerRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+// DeleteDeviceToken godoc
+// @Summary 기기 토큰을 삭제합니다.
+// @Description 로그아웃한 기기에는 더 이상 푸시 알림을 보내지 않습니다.
+// @Tags users
+// @Accept json
+// @Security FirebaseAuth
+// @Param request body notification.DeleteDeviceTokenRequest true "기기 토큰 삭제 요청"
+// @Success 204
+// @Router /users/me/device-tokens [delete]
+func (h *NotificationHandler) DeleteDeviceToken(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	var deleteRequest notification.DeleteDeviceTokenRequest
+	if err := pnd.ParseBody(c, &deleteRequest); err != nil {
+		return err
+	}
+
+	if err := h.notificationService.DeleteDeviceToken(
+		c.Request().Context(), foundUser.ID, deleteRequest.Token,
+	); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/report"
+	"github.com/pet-sitter/pets-next-door-api/internal/service"
+)
+
+type ReportHandler struct {
+	authService   service.AuthService
+	reportService service.ReportService
+}
+
+func NewReportHandler(authService service.AuthService, reportService service.ReportService) *ReportHandler {
+	return &ReportHandler{
+		authService:   authService,
+		reportService: reportService,
+	}
+}
+
+// CreateReport godoc
+// @Summary 사용자, 채팅 메시지, 돌봄급구 게시글을 신고합니다.
+// @Description 같은 대상은 한 번만 신고할 수 있습니다.
+// @Tags reports
+// @Accept  json
+// @Produce  json
+// @Security FirebaseAuth
+// @Param request body report.CreateReportRequest true "신고 요청"
+// @Success 201 {object} report.DetailView
+// @Router /reports [post]
+func (h *ReportHandler) CreateReport(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	var createReportRequest report.CreateReportRequest
+	if err := pnd.ParseBody(c, &createReportRequest); err != nil {
+		return err
+	}
+
+	res, err := h.reportService.CreateReport(c.Request().Context(), foundUser.ID, &createReportRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
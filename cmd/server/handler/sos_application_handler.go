@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/sosapplication"
+	"github.com/pet-sitter/pets-next-door-api/internal/service"
+)
+
+type SOSApplicationHandler struct {
+	authService           service.AuthService
+	sosApplicationService service.SOSApplicationService
+}
+
+func NewSOSApplicationHandler(
+	authService service.AuthService,
+	sosApplicationService service.SOSApplicationService,
+) *SOSApplicationHandler {
+	return &SOSApplicationHandler{
+		authService:           authService,
+		sosApplicationService: sosApplicationService,
+	}
+}
+
+// Apply godoc
+// @Summary 돌봄급구 게시글에 돌보미로 지원합니다.
+// @Description 모집 중인 게시글에만 지원할 수 있으며, 같은 게시글에는 한 번만 지원할 수 있습니다.
+// @Tags posts
+// @Accept  json
+// @Produce  json
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Param request body sosapplication.ApplyRequest true "지원 요청"
+// @Success 201 {object} sosapplication.DetailView
+// @Router /posts/sos/{id}/applications [post]
+func (h *SOSApplicationHandler) Apply(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	sosPostID, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var applyRequest sosapplication.ApplyRequest
+	if err := pnd.ParseBody(c, &applyRequest); err != nil {
+		return err
+	}
+
+	res, err := h.sosApplicationService.Apply(c.Request().Context(), sosPostID, foundUser.ID, &applyRequest)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+// FindApplications godoc
+// @Summary 돌봄급구 게시글의 지원자 목록을 조회합니다.
+// @Description 게시글 작성자만 조회할 수 있습니다.
+// @Tags posts
+// @Produce  json
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Success 200 {object} sosapplication.ListView
+// @Router /posts/sos/{id}/applications [get]
+func (h *SOSApplicationHandler) FindApplications(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	sosPostID, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	res, err := h.sosApplicationService.FindApplications(c.Request().Context(), sosPostID, foundUser.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// AcceptApplication godoc
+// @Summary 돌봄급구 게시글의 지원을 수락합니다.
+// @Description 나머지 지원은 거절되고 게시글은 매칭 완료(matched) 상태가 됩니다. 지원자와의 1:1 채팅방이 함께 만들어집니다.
+// @Tags posts
+// @Produce  json
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Param applicationID path string true "지원 ID"
+// @Success 200 {object} sosapplication.AcceptView
+// @Router /posts/sos/{id}/applications/{applicationID}/accept [post]
+func (h *SOSApplicationHandler) AcceptApplication(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	sosPostID, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	applicationID, err := pnd.ParseIDFromPath(c, "applicationID")
+	if err != nil {
+		return err
+	}
+
+	res, err := h.sosApplicationService.AcceptApplication(
+		c.Request().Context(), sosPostID, applicationID, foundUser.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// RejectApplication godoc
+// @Summary 돌봄급구 게시글의 지원을 거절합니다.
+// @Description 게시글 작성자만 거절할 수 있습니다.
+// @Tags posts
+// @Produce  json
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Param applicationID path string true "지원 ID"
+// @Success 200 {object} sosapplication.DetailView
+// @Router /posts/sos/{id}/applications/{applicationID}/reject [post]
+func (h *SOSApplicationHandler) RejectApplication(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	sosPostID, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	applicationID, err := pnd.ParseIDFromPath(c, "applicationID")
+	if err != nil {
+		return err
+	}
+
+	res, err := h.sosApplicationService.RejectApplication(
+		c.Request().Context(), sosPostID, applicationID, foundUser.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -2,10 +2,14 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/labstack/echo/v4"
 	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/sospost"
 	"github.com/pet-sitter/pets-next-door-api/internal/service"
 )
@@ -63,46 +67,134 @@ func (h *SOSPostHandler) WriteSOSPost(c echo.Context) error {
 
 // FindSOSPosts godoc
 // @Summary 돌봄급구 게시글을 조회합니다.
-// @Description
+// @Description 로그인한 경우 차단한 사용자의 게시글은 제외됩니다.
+// @Description 기준 좌표(latitude, longitude)를 전달하지 않으면 로그인한 사용자의 위치를 기준으로 거리를 계산합니다.
+// @Description 로그인한 경우 게시글마다 저장 여부(isBookmarked)가 포함됩니다.
+// @Description newest, deadline 정렬에서는 응답의 nextCursor를 cursor로 전달해 다음 게시글을 조회할 수 있습니다.
 // @Tags posts
 // @Accept  json
 // @Produce  json
-// @Param author_id query string false "작성자 ID"
+// @Security FirebaseAuth
+// @Param author_id query string false "작성자 ID (filter_type, status 외의 검색 조건, 커서와 함께 사용 불가)"
 // @Param page query int false "페이지 번호" default(1)
 // @Param size query int false "페이지 사이즈" default(20)
-// @Param sort_by query string false "정렬 기준" Enums(newest, deadline)
+// @Param cursor query string false "이전 응답의 nextCursor (newest, deadline 정렬에서 사용, page와 함께 사용 불가)"
+// @Param q query string false "검색어 (제목, 내용)"
+// @Param sort_by query string false "정렬 기준 (검색 시 기본값 relevance)" Enums(newest, deadline, nearest, relevance, popular)
 // @Param filter_type query string false "필터링 기준" Enums(dog, cat, all)
+// @Param status query string false "게시글 상태" Enums(open, matched, completed, cancelled, all)
+// @Param region query string false "행정구역 (하위 지역 포함)"
+// @Param latitude query number false "기준 위도"
+// @Param longitude query number false "기준 경도"
+// @Param radius_km query number false "기준 좌표로부터의 반경(km)"
+// @Param care_type query string false "돌봄 유형" Enums(foster, visiting)
+// @Param reward_type query string false "사례 유형" Enums(fee, gifticon, negotiable)
+// @Param reward_unit query string false "사례 지급 단위" Enums(per_visit, per_day, total)
+// @Param min_reward_amount query int false "최소 사례 금액(원)"
+// @Param max_reward_amount query int false "최대 사례 금액(원)"
+// @Param carer_gender query string false "돌보미 성별 (성별 무관 게시글 포함)" Enums(male, female)
+// @Param condition_ids query []string false "필수 돌봄 조건 ID 목록 (모두 포함)" collectionFormat(csv)
+// @Param date_from query string false "돌봄 기간 시작일 (YYYY-MM-DD)"
+// @Param date_to query string false "돌봄 기간 종료일 (YYYY-MM-DD)"
+// @Param time_from query string false "돌봄 시간대 시작 시각 (HH:MM, 게시글 현지 시각)"
+// @Param time_to query string false "돌봄 시간대 종료 시각 (HH:MM, 게시글 현지 시각)"
+// @Param breed query string false "반려동물 품종"
+// @Param include_past query bool false "돌봄 시작일이 지난 게시글 포함 여부" default(false)
 // @Success 200 {object} sospost.FindSOSPostListView
 // @Router /posts/sos [get]
 func (h *SOSPostHandler) FindSOSPosts(c echo.Context) error {
+	viewerID, err := verifyOptionalAuth(c, h.authService)
+	if err != nil {
+		return err
+	}
+
 	authorID, err := pnd.ParseOptionalUUIDQuery(c, "author_id")
 	if err != nil {
 		return err
 	}
 
-	sortBy := "newest"
-	if sortByQuery := pnd.ParseOptionalStringQuery(c, "sort_by"); sortByQuery != nil {
-		sortBy = *sortByQuery
+	params := sospost.FindSOSPostsParams{
+		ViewerID:   viewerID,
+		SortBy:     sospost.SortByNewest,
+		FilterType: "all",
+		Region:     pnd.ParseOptionalStringQuery(c, "region"),
+	}
+	if keyword := strings.TrimSpace(c.QueryParam("q")); keyword != "" {
+		if utf8.RuneCountInString(keyword) > sospost.MaxSearchKeywordLength {
+			return pnd.ErrInvalidQuery(
+				fmt.Errorf("search keyword must be at most %d characters", sospost.MaxSearchKeywordLength),
+			)
+		}
+		params.Keyword = &keyword
+		params.SortBy = sospost.SortByRelevance
+	}
+	sortByQuery, err := pnd.ParseOptionalEnumQuery(
+		c, "sort_by",
+		sospost.SortByNewest, sospost.SortByDeadline, sospost.SortByNearest, sospost.SortByRelevance,
+		sospost.SortByPopular,
+	)
+	if err != nil {
+		return err
+	}
+	if sortByQuery != nil {
+		params.SortBy = *sortByQuery
+	}
+	filterTypeQuery, err := pnd.ParseOptionalEnumQuery(c, "filter_type", "dog", "cat", "all")
+	if err != nil {
+		return err
+	}
+	if filterTypeQuery != nil {
+		params.FilterType = *filterTypeQuery
+	}
+	if statusQuery := pnd.ParseOptionalStringQuery(c, "status"); statusQuery != nil && *statusQuery != "all" {
+		params.Status = sospost.Status(*statusQuery)
+		if !params.Status.IsValid() {
+			return pnd.ErrInvalidQuery(fmt.Errorf("invalid status: %s", *statusQuery))
+		}
+	}
+
+	if params.Origin, err = parseLocationQueries(c); err != nil {
+		return err
 	}
-	filterType := "all"
-	if filterTypeQuery := pnd.ParseOptionalStringQuery(c, "filter_type"); filterTypeQuery != nil {
-		filterType = *filterTypeQuery
+	if params.RadiusKm, err = pnd.ParseOptionalFloatQuery(c, "radius_km"); err != nil {
+		return err
+	}
+	if params.RadiusKm != nil && (*params.RadiusKm <= 0 || *params.RadiusKm > sospost.MaxRadiusKm) {
+		return pnd.ErrInvalidQuery(fmt.Errorf("radius_km must be between 0 and %d", sospost.MaxRadiusKm))
+	}
+	if err := parseSOSPostFilterQueries(c, &params); err != nil {
+		return err
 	}
 
-	page, size, err := pnd.ParsePaginationQueries(c, 1, 20)
+	if authorID.Valid && params.HasSearchFilters() {
+		return pnd.ErrInvalidQuery(errors.New("search filters cannot be used with author_id"))
+	}
+
+	params.Page, params.Size, err = pnd.ParsePaginationQueries(c, 1, 20)
 	if err != nil {
 		return err
 	}
+	if cursorQuery := pnd.ParseOptionalStringQuery(c, "cursor"); cursorQuery != nil {
+		if c.QueryParam("page") != "" || authorID.Valid {
+			return pnd.ErrInvalidQuery(errors.New("cursor cannot be used with page or author_id"))
+		}
+		if params.Cursor, err = sospost.DecodeCursor(*cursorQuery); err != nil {
+			return err
+		}
+		// 정렬 기준을 생략하면 커서를 만들 때의 정렬 기준을 따른다.
+		if sortByQuery == nil {
+			params.SortBy = params.Cursor.SortBy
+		}
+	}
 
 	var res *sospost.FindSOSPostListView
 	if authorID.Valid {
-		res, err = h.sosPostService.FindSOSPostsByAuthorID(
-			c.Request().Context(), authorID.UUID, page, size, sortBy, filterType)
+		res, err = h.sosPostService.FindSOSPostsByAuthorID(c.Request().Context(), authorID.UUID, params)
 		if err != nil {
 			return err
 		}
 	} else {
-		res, err = h.sosPostService.FindSOSPosts(c.Request().Context(), page, size, sortBy, filterType)
+		res, err = h.sosPostService.FindSOSPosts(c.Request().Context(), params)
 		if err != nil {
 			return err
 		}
@@ -111,11 +203,138 @@ func (h *SOSPostHandler) FindSOSPosts(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// 돌봄 유형, 사례 유형, 돌보미 성별, 사례 금액, 돌봄 조건, 돌봄 기간, 돌봄 시간대, 품종 필터 쿼리를 params에 채운다.
+func parseSOSPostFilterQueries(c echo.Context, params *sospost.FindSOSPostsParams) error {
+	careType, err := pnd.ParseOptionalEnumQuery(
+		c, "care_type", string(sospost.CareTypeFoster), string(sospost.CareTypeVisiting),
+	)
+	if err != nil {
+		return err
+	}
+	rewardType, err := pnd.ParseOptionalEnumQuery(
+		c, "reward_type",
+		string(sospost.RewardTypeFee), string(sospost.RewardTypeGifticon), string(sospost.RewardTypeNegotiable),
+	)
+	if err != nil {
+		return err
+	}
+	carerGender, err := pnd.ParseOptionalEnumQuery(
+		c, "carer_gender", string(sospost.CarerGenderMale), string(sospost.CarerGenderFemale),
+	)
+	if err != nil {
+		return err
+	}
+	if careType != nil {
+		params.CareType = sospost.CareType(*careType)
+	}
+	if rewardType != nil {
+		params.RewardType = sospost.RewardType(*rewardType)
+	}
+	if carerGender != nil {
+		params.CarerGender = sospost.CarerGender(*carerGender)
+	}
+
+	if err := parseRewardAmountQueries(c, params); err != nil {
+		return err
+	}
+
+	if params.ConditionIDs, err = pnd.ParseOptionalUUIDListQuery(c, "condition_ids"); err != nil {
+		return err
+	}
+
+	if params.DateFrom, err = pnd.ParseOptionalDateQuery(c, "date_from"); err != nil {
+		return err
+	}
+	if params.DateTo, err = pnd.ParseOptionalDateQuery(c, "date_to"); err != nil {
+		return err
+	}
+	if params.DateFrom != nil && params.DateTo != nil && params.DateFrom.After(*params.DateTo) {
+		return pnd.ErrInvalidQuery(errors.New("date_from must not be after date_to"))
+	}
+	if params.TimeFrom, err = pnd.ParseOptionalTimeOfDayQuery(c, "time_from"); err != nil {
+		return err
+	}
+	if params.TimeTo, err = pnd.ParseOptionalTimeOfDayQuery(c, "time_to"); err != nil {
+		return err
+	}
+	if params.TimeFrom != nil && params.TimeTo != nil && *params.TimeFrom >= *params.TimeTo {
+		return pnd.ErrInvalidQuery(errors.New("time_from must be before time_to"))
+	}
+
+	params.Breed = pnd.ParseOptionalStringQuery(c, "breed")
+
+	includePast, err := pnd.ParseOptionalBoolQuery(c, "include_past")
+	if err != nil {
+		return err
+	}
+	if includePast != nil {
+		params.IncludePast = *includePast
+	}
+
+	return nil
+}
+
+// 사례 지급 단위와 사례 금액 범위 쿼리를 params에 채운다.
+func parseRewardAmountQueries(c echo.Context, params *sospost.FindSOSPostsParams) error {
+	rewardUnit, err := pnd.ParseOptionalEnumQuery(
+		c, "reward_unit",
+		string(sospost.RewardUnitPerVisit), string(sospost.RewardUnitPerDay), string(sospost.RewardUnitTotal),
+	)
+	if err != nil {
+		return err
+	}
+	if rewardUnit != nil {
+		params.RewardUnit = sospost.RewardUnit(*rewardUnit)
+	}
+
+	if params.MinRewardAmount, err = pnd.ParseOptionalIntQuery(c, "min_reward_amount"); err != nil {
+		return err
+	}
+	if params.MaxRewardAmount, err = pnd.ParseOptionalIntQuery(c, "max_reward_amount"); err != nil {
+		return err
+	}
+	if (params.MinRewardAmount != nil && *params.MinRewardAmount < 0) ||
+		(params.MaxRewardAmount != nil && *params.MaxRewardAmount < 0) {
+		return pnd.ErrInvalidQuery(errors.New("reward amount must not be negative"))
+	}
+	if params.MinRewardAmount != nil && params.MaxRewardAmount != nil &&
+		*params.MinRewardAmount > *params.MaxRewardAmount {
+		return pnd.ErrInvalidQuery(errors.New("min_reward_amount must not be greater than max_reward_amount"))
+	}
+
+	return nil
+}
+
+// latitude, longitude 쿼리를 좌표로 변환한다. 둘 다 없으면 nil을 반환한다.
+func parseLocationQueries(c echo.Context) (*commonvo.Location, error) {
+	latitude, err := pnd.ParseOptionalFloatQuery(c, "latitude")
+	if err != nil {
+		return nil, err
+	}
+	longitude, err := pnd.ParseOptionalFloatQuery(c, "longitude")
+	if err != nil {
+		return nil, err
+	}
+
+	if latitude == nil && longitude == nil {
+		return nil, nil
+	}
+	if latitude == nil || longitude == nil {
+		return nil, pnd.ErrInvalidQuery(errors.New("latitude and longitude must be given together"))
+	}
+	if *latitude < -90 || *latitude > 90 || *longitude < -180 || *longitude > 180 {
+		return nil, pnd.ErrInvalidQuery(errors.New("latitude or longitude is out of range"))
+	}
+
+	return &commonvo.Location{Latitude: *latitude, Longitude: *longitude}, nil
+}
+
 // FindSOSPostByID godoc
 // @Summary 게시글 ID로 돌봄급구 게시글을 조회합니다.
-// @Description
+// @Description 조회수는 같은 사용자(로그인하지 않은 경우 IP)가 하루에 한 번만 올라갑니다.
 // @Tags posts
 // @Produce  json
+// @Security FirebaseAuth
 // @Param id path int true "게시글 ID"
 // @Success 200 {object} sospost.FindSOSPostView
 // @Router /posts/sos/{id} [get]
@@ -124,6 +343,16 @@ func (h *SOSPostHandler) FindSOSPostByID(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	viewerID, err := verifyOptionalAuth(c, h.authService)
+	if err != nil {
+		return err
+	}
+	if err := h.sosPostService.RecordSOSPostView(
+		c.Request().Context(), id, sospost.ViewerKey(viewerID, c.RealIP()),
+	); err != nil {
+		return err
+	}
+
 	res, err := h.sosPostService.FindSOSPostByID(c.Request().Context(), id)
 	if err != nil {
 		return err
@@ -175,3 +404,176 @@ func (h *SOSPostHandler) UpdateSOSPost(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, res)
 }
+
+// UpdateSOSPostStatus godoc
+// @Summary 돌봄급구 게시글의 상태를 변경합니다.
+// @Description open → matched → completed 순서로 변경할 수 있으며, 완료되기 전에는 cancelled로 변경할 수 있습니다.
+// @Tags posts
+// @Accept  json
+// @Produce  json
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Param request body sospost.UpdateSOSPostStatusRequest true "게시글 상태 변경 요청"
+// @Success 200 {object} sospost.FindSOSPostView
+// @Router /posts/sos/{id}/status [put]
+func (h *SOSPostHandler) UpdateSOSPostStatus(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var updateStatusRequest sospost.UpdateSOSPostStatusRequest
+	if err = pnd.ParseBody(c, &updateStatusRequest); err != nil {
+		return err
+	}
+
+	permission, err := h.sosPostService.CheckUpdatePermission(c.Request().Context(), foundUser.FirebaseUID, id)
+	if err != nil {
+		return err
+	}
+	if !permission {
+		return pnd.ErrForbidden(errors.New("해당 게시글에 대한 수정 권한이 없습니다"))
+	}
+
+	res, err := h.sosPostService.UpdateSOSPostStatus(c.Request().Context(), id, updateStatusRequest.Status)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteSOSPost godoc
+// @Summary 돌봄급구 게시글을 삭제합니다.
+// @Description
+// @Tags posts
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Success 204
+// @Router /posts/sos/{id} [delete]
+func (h *SOSPostHandler) DeleteSOSPost(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	permission, err := h.sosPostService.CheckUpdatePermission(c.Request().Context(), foundUser.FirebaseUID, id)
+	if err != nil {
+		return err
+	}
+	if !permission {
+		return pnd.ErrForbidden(errors.New("해당 게시글에 대한 삭제 권한이 없습니다"))
+	}
+
+	if err := h.sosPostService.DeleteSOSPost(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// BookmarkSOSPost godoc
+// @Summary 돌봄급구 게시글을 저장합니다.
+// @Description 이미 저장한 게시글이면 아무것도 하지 않습니다.
+// @Tags posts
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Success 204
+// @Router /posts/sos/{id}/bookmark [post]
+func (h *SOSPostHandler) BookmarkSOSPost(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.sosPostService.BookmarkSOSPost(c.Request().Context(), foundUser.ID, id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// UnbookmarkSOSPost godoc
+// @Summary 돌봄급구 게시글 저장을 취소합니다.
+// @Description
+// @Tags posts
+// @Security FirebaseAuth
+// @Param id path string true "게시글 ID"
+// @Success 204
+// @Router /posts/sos/{id}/bookmark [delete]
+func (h *SOSPostHandler) UnbookmarkSOSPost(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := pnd.ParseIDFromPath(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.sosPostService.UnbookmarkSOSPost(c.Request().Context(), foundUser.ID, id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// FindMyBookmarks godoc
+// @Summary 내가 저장한 돌봄급구 게시글을 조회합니다.
+// @Description 최근에 저장한 게시글부터 조회합니다.
+// @Tags posts,users
+// @Produce  json
+// @Security FirebaseAuth
+// @Param page query int false "페이지 번호" default(1)
+// @Param size query int false "페이지 사이즈" default(20)
+// @Success 200 {object} sospost.FindSOSPostListView
+// @Router /users/me/bookmarks [get]
+func (h *SOSPostHandler) FindMyBookmarks(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	page, size, err := pnd.ParsePaginationQueries(c, 1, 20)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.sosPostService.FindBookmarkedSOSPosts(c.Request().Context(), foundUser.ID, page, size)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
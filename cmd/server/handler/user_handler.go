@@ -232,6 +232,40 @@ func (h *UserHandler) UpdateMyProfile(c echo.Context) error {
 	return c.JSON(http.StatusOK, view)
 }
 
+// UpdateMyLocation godoc
+// @Summary 내 위치를 수정합니다.
+// @Description 돌봄급구 게시글을 거리순으로 조회할 때 기준 좌표로 사용됩니다. 전달하지 않은 값은 지워집니다.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Security FirebaseAuth
+// @Param request body user.UpdateLocationRequest true "위치 수정 요청"
+// @Success 200 {object} user.MyProfileView
+// @Router /users/me/location [put]
+func (h *UserHandler) UpdateMyLocation(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	var updateLocationRequest user.UpdateLocationRequest
+	if err = pnd.ParseBody(c, &updateLocationRequest); err != nil {
+		return err
+	}
+
+	view, err := h.userService.UpdateUserLocationByUID(
+		c.Request().Context(), foundUser.FirebaseUID, &updateLocationRequest,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, view)
+}
+
 // DeleteMyAccount godoc
 // @Summary 내 계정을 삭제합니다.
 // @Description
@@ -255,6 +289,89 @@ func (h *UserHandler) DeleteMyAccount(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// BlockUser godoc
+// @Summary 사용자를 차단합니다.
+// @Description 차단한 사용자와는 1:1 채팅을 할 수 없고, 차단한 사용자의 돌봄급구 게시글은 목록에서 보이지 않습니다.
+// @Tags users
+// @Security FirebaseAuth
+// @Param userID path string true "차단할 사용자 ID"
+// @Success 204
+// @Router /users/{userID}/block [post]
+func (h *UserHandler) BlockUser(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	userID, err := pnd.ParseIDFromPath(c, "userID")
+	if err != nil {
+		return err
+	}
+
+	if err := h.userService.BlockUser(c.Request().Context(), foundUser.ID, userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// UnblockUser godoc
+// @Summary 사용자 차단을 해제합니다.
+// @Description
+// @Tags users
+// @Security FirebaseAuth
+// @Param userID path string true "차단을 해제할 사용자 ID"
+// @Success 204
+// @Router /users/{userID}/block [delete]
+func (h *UserHandler) UnblockUser(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	userID, err := pnd.ParseIDFromPath(c, "userID")
+	if err != nil {
+		return err
+	}
+
+	if err := h.userService.UnblockUser(c.Request().Context(), foundUser.ID, userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// FindMyBlockedUsers godoc
+// @Summary 내가 차단한 사용자 목록을 조회합니다.
+// @Description
+// @Tags users
+// @Produce  json
+// @Security FirebaseAuth
+// @Success 200 {object} user.BlockedUserListView
+// @Router /users/me/blocks [get]
+func (h *UserHandler) FindMyBlockedUsers(c echo.Context) error {
+	foundUser, err := h.authService.VerifyAuthAndGetUser(
+		c.Request().Context(),
+		c.Request().Header.Get("Authorization"),
+	)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.userService.FindBlockedUsers(c.Request().Context(), foundUser.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 // AddMyPets godoc
 // @Summary 내 반려동물을 등록합니다.
 // @Description
@@ -4,6 +4,8 @@ import (
 	"log"
 	"net/http"
 	"time"
+	// 배포 이미지(alpine)에는 시간대 정보가 없으므로 돌봄 시간대 검증을 위해 바이너리에 포함한다.
+	_ "time/tzdata"
 
 	"github.com/rs/zerolog"
 
@@ -28,6 +28,9 @@ import (
 
 func NewRouter(app *firebaseinfra.FirebaseApp) (*echo.Echo, error) {
 	e := echo.New()
+	// 사설망의 프록시(fly.io 등)가 붙인 X-Forwarded-For만 신뢰한다.
+	// 외부에서 직접 보낸 요청은 헤더를 조작해도 연결된 주소를 클라이언트 IP로 사용한다.
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
 	ctx := context.Background()
 
 	db, err := database.Open(configs.DatabaseURL)
@@ -57,26 +60,61 @@ func NewRouter(app *firebaseinfra.FirebaseApp) (*echo.Echo, error) {
 	authService := service.NewFirebaseBearerAuthService(authClient, userService)
 	breedService := service.NewBreedService(db)
 	sosPostService := service.NewSOSPostService(db)
+	sosApplicationService := service.NewSOSApplicationService(db)
 	conditionService := service.NewSOSConditionService(db)
 	chatService := service.NewChatService(db)
+	reportService := service.NewReportService(db)
+
+	// 로컬 개발 환경에서는 memory notifier로 실제 푸시 알림을 보내지 않을 수 있다.
+	var notifier firebaseinfra.Notifier
+	switch configs.PushNotifier {
+	case "memory":
+		notifier = firebaseinfra.NewInMemoryNotifier()
+	case "fcm":
+		notifier, err = firebaseinfra.NewFCMNotifier(ctx, app)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing push notifier: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown push notifier: %s", configs.PushNotifier)
+	}
+	notificationService := service.NewNotificationService(db, notifier)
+
+	// 여러 인스턴스로 실행할 때는 postgres broker를 사용해야 다른 인스턴스의 사용자에게도 메시지가 전달된다.
+	var broker wschat.Broker
+	switch configs.ChatBroker {
+	case "memory":
+		broker = wschat.NewInMemoryBroker()
+	case "postgres":
+		broker, err = wschat.NewPostgresBroker(configs.DatabaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing chat broker: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown chat broker: %s", configs.ChatBroker)
+	}
+
+	upgrader := wschat.NewDefaultUpgrader()
+	wsServerV2 := wschat.NewWSServer(
+		upgrader, broker, authService, *mediaService, *chatService, *notificationService,
+	)
+	if err := wsServerV2.Subscribe(ctx); err != nil {
+		return nil, fmt.Errorf("error subscribing chat broker: %w", err)
+	}
+
+	go wsServerV2.LoopOverClientMessages()
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authService, kakaoinfra.NewKakaoDefaultClient())
 	userHandler := handler.NewUserHandler(*userService, authService)
-	mediaHandler := handler.NewMediaHandler(*mediaService)
+	mediaHandler := handler.NewMediaHandler(authService, *mediaService)
 	breedHandler := handler.NewBreedHandler(*breedService)
 	sosPostHandler := handler.NewSOSPostHandler(*sosPostService, authService)
+	sosApplicationHandler := handler.NewSOSApplicationHandler(authService, *sosApplicationService)
 	conditionHandler := handler.NewConditionHandler(*conditionService)
-	chatHandler := handler.NewChatHandler(authService, *chatService)
-
-	// // InMemoryStateManager는 클라이언트와 채팅방의 상태를 메모리에 저장하고 관리합니다.
-	// // 이 메서드는 단순하고 빠르며 테스트 목적으로 적합합니다.
-	// // 전략 패턴을 사용하여 이 부분을 다른 상태 관리 구현체로 쉽게 교체할 수 있습니다.
-	// stateManager := chat.NewInMemoryStateManager()
-	// wsServer := chat.NewWebSocketServer(stateManager)
-	// go wsServer.Run()
-	// chat.InitializeWebSocketServer(ctx, wsServer, chatService)
-	// chatHandler := handler.NewChatHandler(wsServer, stateManager, authService, *chatService)
+	chatHandler := handler.NewChatHandler(authService, *chatService, wsServerV2)
+	notificationHandler := handler.NewNotificationHandler(authService, *notificationService)
+	reportHandler := handler.NewReportHandler(authService, *reportService)
 
 	// RegisterChan middlewares
 	logger := zerolog.New(os.Stdout)
@@ -149,11 +187,23 @@ func NewRouter(app *firebaseinfra.FirebaseApp) (*echo.Echo, error) {
 		userAPIGroup.GET("/:userID", userHandler.FindUserByID)
 		userAPIGroup.GET("/me", userHandler.FindMyProfile)
 		userAPIGroup.PUT("/me", userHandler.UpdateMyProfile)
+		userAPIGroup.PUT("/me/location", userHandler.UpdateMyLocation)
 		userAPIGroup.DELETE("/me", userHandler.DeleteMyAccount)
 		userAPIGroup.GET("/me/pets", userHandler.FindMyPets)
 		userAPIGroup.PUT("/me/pets", userHandler.AddMyPets)
 		userAPIGroup.PUT("/me/pets/:petID", userHandler.UpdateMyPet)
 		userAPIGroup.DELETE("/me/pets/:petID", userHandler.DeleteMyPet)
+		userAPIGroup.POST("/me/device-tokens", notificationHandler.RegisterDeviceToken)
+		userAPIGroup.DELETE("/me/device-tokens", notificationHandler.DeleteDeviceToken)
+		userAPIGroup.GET("/me/blocks", userHandler.FindMyBlockedUsers)
+		userAPIGroup.GET("/me/bookmarks", sosPostHandler.FindMyBookmarks)
+		userAPIGroup.POST("/:userID/block", userHandler.BlockUser)
+		userAPIGroup.DELETE("/:userID/block", userHandler.UnblockUser)
+	}
+
+	reportAPIGroup := apiRouteGroup.Group("/reports")
+	{
+		reportAPIGroup.POST("", reportHandler.CreateReport)
 	}
 
 	breedAPIGroup := apiRouteGroup.Group("/breeds")
@@ -167,23 +217,34 @@ func NewRouter(app *firebaseinfra.FirebaseApp) (*echo.Echo, error) {
 		postAPIGroup.GET("/sos/:id", sosPostHandler.FindSOSPostByID)
 		postAPIGroup.GET("/sos", sosPostHandler.FindSOSPosts)
 		postAPIGroup.PUT("/sos", sosPostHandler.UpdateSOSPost)
+		postAPIGroup.DELETE("/sos/:id", sosPostHandler.DeleteSOSPost)
+		postAPIGroup.PUT("/sos/:id/status", sosPostHandler.UpdateSOSPostStatus)
+		postAPIGroup.POST("/sos/:id/bookmark", sosPostHandler.BookmarkSOSPost)
+		postAPIGroup.DELETE("/sos/:id/bookmark", sosPostHandler.UnbookmarkSOSPost)
+		postAPIGroup.POST("/sos/:id/applications", sosApplicationHandler.Apply)
+		postAPIGroup.GET("/sos/:id/applications", sosApplicationHandler.FindApplications)
+		postAPIGroup.POST("/sos/:id/applications/:applicationID/accept", sosApplicationHandler.AcceptApplication)
+		postAPIGroup.POST("/sos/:id/applications/:applicationID/reject", sosApplicationHandler.RejectApplication)
 		postAPIGroup.GET("/sos/conditions", conditionHandler.FindConditions)
 	}
 
-	upgrader := wschat.NewDefaultUpgrader()
-	wsServerV2 := wschat.NewWSServer(upgrader, authService, *mediaService)
-
-	go wsServerV2.LoopOverClientMessages()
-
 	chatAPIGroup := apiRouteGroup.Group("/chat")
 	{
 		chatAPIGroup.GET("/ws", wsServerV2.HandleConnections)
 		chatAPIGroup.POST("/rooms", chatHandler.CreateRoom)
 		chatAPIGroup.PUT("/rooms/:roomID/join", chatHandler.JoinChatRoom)
 		chatAPIGroup.PUT("/rooms/:roomID/leave", chatHandler.LeaveChatRoom)
+		chatAPIGroup.GET("/rooms/direct/:userID", chatHandler.FindDirectRoom)
 		chatAPIGroup.GET("/rooms/:roomID", chatHandler.FindRoomByID)
+		chatAPIGroup.PUT("/rooms/:roomID", chatHandler.UpdateRoom)
+		chatAPIGroup.DELETE("/rooms/:roomID", chatHandler.DeleteRoom)
+		chatAPIGroup.GET("/rooms/:roomID/members", chatHandler.FindRoomMembers)
+		chatAPIGroup.PUT("/rooms/:roomID/members/:userID/role", chatHandler.UpdateMemberRole)
 		chatAPIGroup.GET("/rooms", chatHandler.FindAllRooms)
 		chatAPIGroup.GET("/rooms/:roomID/messages", chatHandler.FindMessagesByRoomID)
+		chatAPIGroup.GET("/rooms/:roomID/messages/search", chatHandler.SearchMessages)
+		chatAPIGroup.PUT("/rooms/:roomID/read", chatHandler.ReadChatRoom)
+		chatAPIGroup.GET("/rooms/:roomID/online", chatHandler.FindOnlineMembers)
 	}
 
 	return e, nil
@@ -0,0 +1,10 @@
+-- NOTIFY payload 크기 제한(8000 bytes)을 넘는 채팅 Envelope를 인스턴스 간에 전달하기 위해 잠시 보관한다.
+-- NOTIFY로는 ID만 전달하고, 구독하는 인스턴스가 이 테이블에서 내용을 읽는다.
+CREATE TABLE IF NOT EXISTS chat_envelopes
+(
+    id         UUID PRIMARY KEY,
+    envelope   JSONB       NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS chat_envelopes_created_at_idx ON chat_envelopes (created_at);
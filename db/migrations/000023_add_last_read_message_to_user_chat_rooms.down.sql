@@ -0,0 +1,2 @@
+ALTER TABLE user_chat_rooms
+    DROP COLUMN last_read_message_id;
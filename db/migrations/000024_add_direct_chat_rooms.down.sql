@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS chat_rooms_direct_key_idx;
+
+ALTER TABLE chat_rooms
+    DROP COLUMN sos_post_id,
+    DROP COLUMN direct_key;
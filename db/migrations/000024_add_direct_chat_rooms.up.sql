@@ -0,0 +1,6 @@
+ALTER TABLE chat_rooms
+    ADD COLUMN sos_post_id UUID REFERENCES sos_posts (id),
+    ADD COLUMN direct_key  VARCHAR(255);
+
+-- 두 사용자 사이의 1:1 채팅방은 하나만 존재한다.
+CREATE UNIQUE INDEX chat_rooms_direct_key_idx ON chat_rooms (direct_key) WHERE deleted_at IS NULL;
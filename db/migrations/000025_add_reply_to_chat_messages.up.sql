@@ -0,0 +1,2 @@
+ALTER TABLE chat_messages
+    ADD COLUMN reply_to_id UUID REFERENCES chat_messages (id);
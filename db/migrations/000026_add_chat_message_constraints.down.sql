@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS chat_messages_user_id_client_message_id_idx;
+
+ALTER TABLE chat_messages
+    DROP COLUMN client_message_id;
+
+ALTER TABLE media
+    DROP COLUMN uploader_id;
@@ -0,0 +1,8 @@
+ALTER TABLE media
+    ADD COLUMN uploader_id UUID REFERENCES users (id);
+
+ALTER TABLE chat_messages
+    ADD COLUMN client_message_id VARCHAR(64);
+
+-- 재연결 후 같은 메시지를 다시 보내도 중복 저장되지 않도록 한다.
+CREATE UNIQUE INDEX chat_messages_user_id_client_message_id_idx ON chat_messages (user_id, client_message_id);
@@ -0,0 +1,14 @@
+-- 푸시 알림을 받을 사용자 기기의 FCM 토큰
+CREATE TABLE IF NOT EXISTS user_device_tokens
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID         NOT NULL REFERENCES users (id),
+    token      VARCHAR(512) NOT NULL,
+    platform   VARCHAR(20)  NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 하나의 기기 토큰은 마지막으로 등록한 사용자에게만 속한다.
+CREATE UNIQUE INDEX IF NOT EXISTS user_device_tokens_token_idx ON user_device_tokens (token);
+CREATE INDEX IF NOT EXISTS user_device_tokens_user_id_idx ON user_device_tokens (user_id);
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS user_blocks
+(
+    id         UUID PRIMARY KEY,
+    blocker_id UUID      NOT NULL REFERENCES users (id),
+    blocked_id UUID      NOT NULL REFERENCES users (id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_blocks_blocker_id_blocked_id_idx ON user_blocks (blocker_id, blocked_id);
+CREATE INDEX IF NOT EXISTS user_blocks_blocked_id_idx ON user_blocks (blocked_id);
+
+-- 사용자, 채팅 메시지, 돌봄급구 게시글에 대한 신고
+CREATE TABLE IF NOT EXISTS reports
+(
+    id               UUID PRIMARY KEY,
+    reporter_id      UUID        NOT NULL REFERENCES users (id),
+    reported_user_id UUID        NOT NULL REFERENCES users (id),
+    target_type      VARCHAR(20) NOT NULL,
+    target_id        UUID        NOT NULL,
+    reason           VARCHAR(20) NOT NULL,
+    description      TEXT,
+    status           VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at       TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at       TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 같은 대상을 여러 번 신고할 수 없다.
+CREATE UNIQUE INDEX IF NOT EXISTS reports_reporter_id_target_idx ON reports (reporter_id, target_type, target_id);
+CREATE INDEX IF NOT EXISTS reports_status_idx ON reports (status);
@@ -0,0 +1,5 @@
+ALTER TABLE chat_rooms
+    DROP COLUMN IF EXISTS image_id;
+
+ALTER TABLE user_chat_rooms
+    DROP COLUMN IF EXISTS role;
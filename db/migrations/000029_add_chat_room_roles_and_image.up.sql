@@ -0,0 +1,17 @@
+-- 채팅방 참여자의 역할 (owner, admin, member)
+ALTER TABLE user_chat_rooms
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'member';
+
+-- 기존 채팅방은 현재 참여자 중 가장 먼저 참여한 사용자를 방장으로 지정한다. 1:1 채팅방에는 방장이 없다.
+UPDATE user_chat_rooms
+SET role = 'owner'
+WHERE id IN (SELECT DISTINCT ON (user_chat_rooms.room_id) user_chat_rooms.id
+             FROM user_chat_rooms
+                      JOIN chat_rooms
+                           ON chat_rooms.id = user_chat_rooms.room_id
+             WHERE user_chat_rooms.left_at IS NULL
+               AND chat_rooms.room_type <> 'direct'
+             ORDER BY user_chat_rooms.room_id, user_chat_rooms.joined_at, user_chat_rooms.id);
+
+ALTER TABLE chat_rooms
+    ADD COLUMN IF NOT EXISTS image_id UUID REFERENCES media (id);
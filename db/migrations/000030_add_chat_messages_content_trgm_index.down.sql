@@ -0,0 +1 @@
+DROP INDEX IF EXISTS chat_messages_content_trgm_idx;
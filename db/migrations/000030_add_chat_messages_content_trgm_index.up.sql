@@ -0,0 +1,4 @@
+-- 채팅 메시지 검색 (content ILIKE '%검색어%')에 사용하는 trigram 인덱스
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS chat_messages_content_trgm_idx ON chat_messages USING GIN (content gin_trgm_ops);
@@ -0,0 +1,30 @@
+-- 돌봄급구 게시글 상태 (open → matched → completed / cancelled)
+ALTER TABLE sos_posts
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'open';
+
+CREATE INDEX IF NOT EXISTS sos_posts_status_idx ON sos_posts (status);
+
+-- 돌봄 급구(SosPosts) 테이블 VIEW에 상태를 추가한다.
+DROP VIEW IF EXISTS v_sos_posts;
+CREATE VIEW v_sos_posts AS
+SELECT sos_posts.id,
+       sos_posts.title,
+       sos_posts.content,
+       sos_posts.reward,
+       sos_posts.reward_type,
+       sos_posts.care_type,
+       sos_posts.carer_gender,
+       sos_posts.thumbnail_id,
+       sos_posts.author_id,
+       sos_posts.created_at,
+       sos_posts.updated_at,
+       sos_posts.status,
+       MIN(sos_dates.date_start_at)                                      AS earliest_date_start_at,
+       json_agg(sos_dates.*) FILTER (WHERE sos_dates.deleted_at IS NULL) AS dates
+FROM sos_posts
+         LEFT JOIN sos_posts_dates ON sos_posts.id = sos_posts_dates.sos_post_id
+         LEFT JOIN sos_dates ON sos_posts_dates.sos_dates_id = sos_dates.id
+WHERE sos_posts.deleted_at IS NULL
+  AND sos_dates.deleted_at IS NULL
+  AND sos_posts_dates.deleted_at IS NULL
+GROUP BY sos_posts.id;
@@ -0,0 +1,16 @@
+-- 돌봄급구 게시글에 대한 돌보미 지원
+CREATE TABLE IF NOT EXISTS sos_post_applications
+(
+    id           UUID PRIMARY KEY,
+    sos_post_id  UUID        NOT NULL REFERENCES sos_posts (id),
+    applicant_id UUID        NOT NULL REFERENCES users (id),
+    message      TEXT        NOT NULL,
+    status       VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 같은 게시글에는 한 번만 지원할 수 있다.
+CREATE UNIQUE INDEX IF NOT EXISTS sos_post_applications_sos_post_id_applicant_id_idx
+    ON sos_post_applications (sos_post_id, applicant_id);
+CREATE INDEX IF NOT EXISTS sos_post_applications_applicant_id_idx ON sos_post_applications (applicant_id);
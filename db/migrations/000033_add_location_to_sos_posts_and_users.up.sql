@@ -0,0 +1,53 @@
+-- 돌봄급구 게시글과 사용자의 위치 (행정구역, 좌표)
+ALTER TABLE sos_posts
+    ADD COLUMN IF NOT EXISTS region    VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS latitude  DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS region    VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS latitude  DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+-- 두 좌표 사이의 거리(km)를 하버사인 공식으로 계산한다.
+-- 좌표 중 하나라도 NULL이면 NULL을 반환한다.
+CREATE OR REPLACE FUNCTION distance_km(lat1 DOUBLE PRECISION, lng1 DOUBLE PRECISION,
+                                       lat2 DOUBLE PRECISION, lng2 DOUBLE PRECISION)
+    RETURNS DOUBLE PRECISION
+    LANGUAGE SQL
+    IMMUTABLE
+AS
+$$
+SELECT 6371 * 2 * ASIN(LEAST(1, SQRT(
+        POWER(SIN(RADIANS(lat2 - lat1) / 2), 2) +
+        COS(RADIANS(lat1)) * COS(RADIANS(lat2)) * POWER(SIN(RADIANS(lng2 - lng1) / 2), 2)
+    )))
+$$;
+
+-- 돌봄 급구(SosPosts) 테이블 VIEW에 위치를 추가한다.
+DROP VIEW IF EXISTS v_sos_posts;
+CREATE VIEW v_sos_posts AS
+SELECT sos_posts.id,
+       sos_posts.title,
+       sos_posts.content,
+       sos_posts.reward,
+       sos_posts.reward_type,
+       sos_posts.care_type,
+       sos_posts.carer_gender,
+       sos_posts.thumbnail_id,
+       sos_posts.author_id,
+       sos_posts.created_at,
+       sos_posts.updated_at,
+       sos_posts.status,
+       sos_posts.region,
+       sos_posts.latitude,
+       sos_posts.longitude,
+       MIN(sos_dates.date_start_at)                                      AS earliest_date_start_at,
+       json_agg(sos_dates.*) FILTER (WHERE sos_dates.deleted_at IS NULL) AS dates
+FROM sos_posts
+         LEFT JOIN sos_posts_dates ON sos_posts.id = sos_posts_dates.sos_post_id
+         LEFT JOIN sos_dates ON sos_posts_dates.sos_dates_id = sos_dates.id
+WHERE sos_posts.deleted_at IS NULL
+  AND sos_dates.deleted_at IS NULL
+  AND sos_posts_dates.deleted_at IS NULL
+GROUP BY sos_posts.id;
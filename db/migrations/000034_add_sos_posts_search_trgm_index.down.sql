@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS sos_posts_content_trgm_idx;
+DROP INDEX IF EXISTS sos_posts_title_trgm_idx;
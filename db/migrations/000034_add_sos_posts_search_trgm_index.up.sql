@@ -0,0 +1,5 @@
+-- 돌봄급구 게시글 검색 (title, content ILIKE '%검색어%')에 사용하는 trigram 인덱스
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS sos_posts_title_trgm_idx ON sos_posts USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS sos_posts_content_trgm_idx ON sos_posts USING GIN (content gin_trgm_ops);
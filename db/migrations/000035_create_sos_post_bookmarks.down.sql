@@ -0,0 +1 @@
+DROP TABLE IF EXISTS sos_post_bookmarks;
@@ -0,0 +1,11 @@
+-- 사용자가 저장한 돌봄급구 게시글
+CREATE TABLE IF NOT EXISTS sos_post_bookmarks
+(
+    id          UUID PRIMARY KEY,
+    user_id     UUID      NOT NULL REFERENCES users (id),
+    sos_post_id UUID      NOT NULL REFERENCES sos_posts (id),
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sos_post_bookmarks_user_id_sos_post_id_idx ON sos_post_bookmarks (user_id, sos_post_id);
+CREATE INDEX IF NOT EXISTS sos_post_bookmarks_sos_post_id_idx ON sos_post_bookmarks (sos_post_id);
@@ -0,0 +1,38 @@
+DROP VIEW IF EXISTS v_sos_posts;
+CREATE VIEW v_sos_posts AS
+SELECT sos_posts.id,
+       sos_posts.title,
+       sos_posts.content,
+       sos_posts.reward,
+       sos_posts.reward_type,
+       sos_posts.care_type,
+       sos_posts.carer_gender,
+       sos_posts.thumbnail_id,
+       sos_posts.author_id,
+       sos_posts.created_at,
+       sos_posts.updated_at,
+       sos_posts.status,
+       sos_posts.region,
+       sos_posts.latitude,
+       sos_posts.longitude,
+       sos_posts.view_count,
+       (SELECT COUNT(*)
+        FROM sos_post_applications
+        WHERE sos_post_applications.sos_post_id = sos_posts.id)          AS application_count,
+       (SELECT COUNT(*)
+        FROM sos_post_bookmarks
+        WHERE sos_post_bookmarks.sos_post_id = sos_posts.id)             AS bookmark_count,
+       MIN(sos_dates.date_start_at)                                      AS earliest_date_start_at,
+       json_agg(sos_dates.*) FILTER (WHERE sos_dates.deleted_at IS NULL) AS dates
+FROM sos_posts
+         LEFT JOIN sos_posts_dates ON sos_posts.id = sos_posts_dates.sos_post_id
+         LEFT JOIN sos_dates ON sos_posts_dates.sos_dates_id = sos_dates.id
+WHERE sos_posts.deleted_at IS NULL
+  AND sos_dates.deleted_at IS NULL
+  AND sos_posts_dates.deleted_at IS NULL
+GROUP BY sos_posts.id;
+
+ALTER TABLE sos_posts
+    DROP COLUMN IF EXISTS reward_amount,
+    DROP COLUMN IF EXISTS reward_unit,
+    DROP COLUMN IF EXISTS gifticon_description;
@@ -0,0 +1,75 @@
+-- 돌봄급구 게시글의 사례 금액(원), 지급 단위, 기프티콘 설명
+ALTER TABLE sos_posts
+    ADD COLUMN IF NOT EXISTS reward_amount        INTEGER,
+    ADD COLUMN IF NOT EXISTS reward_unit          VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS gifticon_description VARCHAR(100);
+
+-- 기존 자유 형식 사례에서 금액이 하나만 적힌 경우 금액과 지급 단위를 추출한다.
+-- 예: "10,000원" -> 10000, "회당 2만원" -> 20000 (per_visit), "1.5만" -> 15000
+-- "1회 2만원"처럼 숫자가 여러 개 있는 경우는 해석하지 않는다.
+UPDATE sos_posts
+SET reward_amount = parsed.amount,
+    reward_unit   = CASE
+                        WHEN sos_posts.reward ~ '(회당|방문당|번당)' THEN 'per_visit'
+                        WHEN sos_posts.reward ~ '(일당|하루|박당)' THEN 'per_day'
+                        ELSE 'total'
+        END
+FROM (SELECT id,
+             CASE
+                 WHEN normalized ~ '^[^0-9]*[0-9]+(\.[0-9]+)?만원?[^0-9]*$'
+                     THEN ROUND(SUBSTRING(normalized FROM '([0-9]+(\.[0-9]+)?)만')::NUMERIC * 10000)
+                 WHEN normalized ~ '^[^0-9]*[0-9]+천원?[^0-9]*$'
+                     THEN SUBSTRING(normalized FROM '([0-9]+)천')::NUMERIC * 1000
+                 WHEN normalized ~ '^[^0-9]*[0-9]+원?[^0-9]*$'
+                     THEN SUBSTRING(normalized FROM '([0-9]+)')::NUMERIC
+                 END AS amount
+      FROM (SELECT id, REGEXP_REPLACE(reward, '[\s,]', '', 'g') AS normalized
+            FROM sos_posts
+            WHERE reward_type IN ('fee', 'negotiable')) AS normalized_rewards) AS parsed
+WHERE sos_posts.id = parsed.id
+  AND parsed.amount BETWEEN 0 AND 10000000;
+
+-- 기프티콘 사례는 기존 사례 문구를 기프티콘 설명으로 옮긴다.
+UPDATE sos_posts
+SET gifticon_description = reward
+WHERE reward_type = 'gifticon'
+  AND reward IS NOT NULL
+  AND reward <> '';
+
+-- 돌봄 급구(SosPosts) 테이블 VIEW에 사례 금액, 지급 단위, 기프티콘 설명을 추가한다.
+DROP VIEW IF EXISTS v_sos_posts;
+CREATE VIEW v_sos_posts AS
+SELECT sos_posts.id,
+       sos_posts.title,
+       sos_posts.content,
+       sos_posts.reward,
+       sos_posts.reward_type,
+       sos_posts.reward_amount,
+       sos_posts.reward_unit,
+       sos_posts.gifticon_description,
+       sos_posts.care_type,
+       sos_posts.carer_gender,
+       sos_posts.thumbnail_id,
+       sos_posts.author_id,
+       sos_posts.created_at,
+       sos_posts.updated_at,
+       sos_posts.status,
+       sos_posts.region,
+       sos_posts.latitude,
+       sos_posts.longitude,
+       sos_posts.view_count,
+       (SELECT COUNT(*)
+        FROM sos_post_applications
+        WHERE sos_post_applications.sos_post_id = sos_posts.id)          AS application_count,
+       (SELECT COUNT(*)
+        FROM sos_post_bookmarks
+        WHERE sos_post_bookmarks.sos_post_id = sos_posts.id)             AS bookmark_count,
+       MIN(sos_dates.date_start_at)                                      AS earliest_date_start_at,
+       json_agg(sos_dates.*) FILTER (WHERE sos_dates.deleted_at IS NULL) AS dates
+FROM sos_posts
+         LEFT JOIN sos_posts_dates ON sos_posts.id = sos_posts_dates.sos_post_id
+         LEFT JOIN sos_dates ON sos_posts_dates.sos_dates_id = sos_dates.id
+WHERE sos_posts.deleted_at IS NULL
+  AND sos_dates.deleted_at IS NULL
+  AND sos_posts_dates.deleted_at IS NULL
+GROUP BY sos_posts.id;
@@ -0,0 +1,50 @@
+-- 돌봄 날짜별로 매일 반복되는 돌봄 시간대와 그 기준이 되는 시간대(timezone)
+-- time_slots가 비어 있으면 하루 종일 돌봄이 필요하다는 뜻이다.
+ALTER TABLE sos_dates
+    ADD COLUMN IF NOT EXISTS time_slots JSONB       NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS timezone   VARCHAR(50) NOT NULL DEFAULT 'Asia/Seoul',
+    ADD COLUMN IF NOT EXISTS start_at   TIMESTAMPTZ;
+
+-- 돌봄이 처음 시작되는 시각. 기존 돌봄 날짜는 하루 종일 돌봄이므로 시작일 0시로 채운다.
+UPDATE sos_dates
+SET start_at = date_start_at::TIMESTAMP AT TIME ZONE timezone
+WHERE start_at IS NULL;
+
+-- 돌봄 급구(SosPosts) 테이블 VIEW에 가장 이른 돌봄 시작 시각을 추가한다.
+DROP VIEW IF EXISTS v_sos_posts;
+CREATE VIEW v_sos_posts AS
+SELECT sos_posts.id,
+       sos_posts.title,
+       sos_posts.content,
+       sos_posts.reward,
+       sos_posts.reward_type,
+       sos_posts.reward_amount,
+       sos_posts.reward_unit,
+       sos_posts.gifticon_description,
+       sos_posts.care_type,
+       sos_posts.carer_gender,
+       sos_posts.thumbnail_id,
+       sos_posts.author_id,
+       sos_posts.created_at,
+       sos_posts.updated_at,
+       sos_posts.status,
+       sos_posts.region,
+       sos_posts.latitude,
+       sos_posts.longitude,
+       sos_posts.view_count,
+       (SELECT COUNT(*)
+        FROM sos_post_applications
+        WHERE sos_post_applications.sos_post_id = sos_posts.id)          AS application_count,
+       (SELECT COUNT(*)
+        FROM sos_post_bookmarks
+        WHERE sos_post_bookmarks.sos_post_id = sos_posts.id)             AS bookmark_count,
+       MIN(sos_dates.date_start_at)                                      AS earliest_date_start_at,
+       MIN(sos_dates.start_at)                                           AS earliest_start_at,
+       json_agg(sos_dates.*) FILTER (WHERE sos_dates.deleted_at IS NULL) AS dates
+FROM sos_posts
+         LEFT JOIN sos_posts_dates ON sos_posts.id = sos_posts_dates.sos_post_id
+         LEFT JOIN sos_dates ON sos_posts_dates.sos_dates_id = sos_dates.id
+WHERE sos_posts.deleted_at IS NULL
+  AND sos_dates.deleted_at IS NULL
+  AND sos_posts_dates.deleted_at IS NULL
+GROUP BY sos_posts.id;
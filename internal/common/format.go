@@ -1,6 +1,11 @@
 package utils
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+var likePatternReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 
 // FormatDateString formats datetime string to date string.
 // Example: 2021-01-01T00:00:00Z -> 2021-01-01
@@ -30,3 +35,9 @@ func FormatDateTimeString(datetimeStr string) time.Time {
 
 	return t
 }
+
+// EscapeLikePattern escapes LIKE pattern characters (%, _) so that they match literally.
+// Example: 100% -> 100\%
+func EscapeLikePattern(keyword string) string {
+	return likePatternReplacer.Replace(keyword)
+}
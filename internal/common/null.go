@@ -49,6 +49,14 @@ func NullInt64ToInt64Ptr(val sql.NullInt64) *int64 {
 	return nil
 }
 
+func NullInt32ToIntPtr(val sql.NullInt32) *int {
+	if val.Valid {
+		intVal := int(val.Int32)
+		return &intVal
+	}
+	return nil
+}
+
 func IntToNullInt64(val int) sql.NullInt64 {
 	return sql.NullInt64{
 		Int64: int64(val),
@@ -98,6 +106,13 @@ func Int64PtrToNullInt32(val *int64) sql.NullInt32 {
 	}
 }
 
+func FloatPtrToNullFloat64(val *float64) sql.NullFloat64 {
+	return sql.NullFloat64{
+		Float64: DerefOrEmpty(val),
+		Valid:   IsNotNil(val),
+	}
+}
+
 func StrToNullTime(val string) (sql.NullTime, error) {
 	const timeLayout = "2006-01-02"
 	parsedTime, err := time.Parse(timeLayout, val)
@@ -112,6 +127,13 @@ func StrToNullTime(val string) (sql.NullTime, error) {
 	}, nil
 }
 
+func TimePtrToNullTime(val *time.Time) sql.NullTime {
+	return sql.NullTime{
+		Time:  DerefOrEmpty(val),
+		Valid: IsNotNil(val),
+	}
+}
+
 func NullTimeToStr(val sql.NullTime) string {
 	if val.Valid {
 		return val.Time.Format("2006-01-02")
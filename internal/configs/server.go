@@ -3,6 +3,7 @@ package configs
 import (
 	"os"
 	"strings"
+	"testing"
 
 	// Load environment variables from .env file
 	_ "github.com/joho/godotenv/autoload"
@@ -15,6 +16,12 @@ var (
 	MigrationPath = os.Getenv("MIGRATION_PATH")
 )
 
+// ChatBroker 채팅 메시지를 인스턴스 간에 전달하는 방식 (postgres, memory)
+var ChatBroker = os.Getenv("CHAT_BROKER")
+
+// PushNotifier 푸시 알림을 보내는 방식 (fcm, memory)
+var PushNotifier = os.Getenv("PUSH_NOTIFIER")
+
 var (
 	KakaoRestAPIKey  = os.Getenv("KAKAO_REST_API_KEY")
 	KakaoRedirectURI = os.Getenv("KAKAO_REDIRECT_URI")
@@ -75,14 +82,28 @@ func init() {
 		Port = "8080"
 	}
 
-	if DatabaseURL == "" {
-		panic("DATABASE_URL is required")
-	}
-
 	if MigrationPath == "" {
 		MigrationPath = "db/migrations"
 	}
 
+	if ChatBroker == "" {
+		ChatBroker = "postgres"
+	}
+
+	if PushNotifier == "" {
+		PushNotifier = "fcm"
+	}
+
+	// 테스트는 외부 서비스 설정 없이도 실행할 수 있어야 한다.
+	// DB가 필요한 테스트는 DATABASE_URL이 없으면 직접 건너뛴다.
+	if testing.Testing() {
+		return
+	}
+
+	if DatabaseURL == "" {
+		panic("DATABASE_URL is required")
+	}
+
 	if KakaoRestAPIKey == "" {
 		panic("KAKAO_REST_API_KEY is required")
 	}
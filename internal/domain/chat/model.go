@@ -1,19 +1,22 @@
 package chat
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 )
 
 type (
 	RoomType    string
 	MessageType string
+	Role        string
 )
 
 func (t RoomType) IsValid() bool {
 	switch t {
-	case EventRoomType:
+	case EventRoomType, DirectRoomType:
 		return true
 	default:
 		return false
@@ -21,11 +24,53 @@ func (t RoomType) IsValid() bool {
 }
 
 const (
-	EventRoomType = "event"
+	EventRoomType  = "event"
+	DirectRoomType = "direct"
 )
 
 const (
 	EventMessage = "event"
+	PlainMessage = "plain"
+	MediaMessage = "media"
+)
+
+// 채팅방 참여자의 역할
+// 방장은 채팅방 정보 수정, 참여자 역할 변경, 채팅방 삭제를 할 수 있고, 관리자는 채팅방 정보를 수정할 수 있다.
+// 1:1 채팅방의 참여자는 모두 일반 참여자이다.
+const (
+	OwnerRole  Role = "owner"
+	AdminRole  Role = "admin"
+	MemberRole Role = "member"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case OwnerRole, AdminRole, MemberRole:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanManageRoom 채팅방 이름과 이미지를 수정할 수 있는지 여부
+func (r Role) CanManageRoom() bool {
+	return r == OwnerRole || r == AdminRole
+}
+
+// MessageEditableDuration 메시지를 수정하거나 삭제할 수 있는 기간
+const MessageEditableDuration = 15 * time.Minute
+
+const (
+	// 메시지 본문의 최대 길이 (글자 수 기준)
+	MaxMessageLength = 2000
+	// 하나의 메시지에 첨부할 수 있는 최대 미디어 수
+	MaxMediasPerMessage = 10
+	// 클라이언트가 재전송 중복 제거를 위해 보내는 메시지 ID의 최대 길이
+	MaxClientMessageIDLength = 64
+	// 메시지 검색어의 최대 길이 (글자 수 기준)
+	MaxSearchKeywordLength = 100
+	// 검색 결과 미리보기에서 검색어 앞뒤로 보여줄 글자 수
+	SearchSnippetRadius = 30
 )
 
 type RoomSimpleInfo struct {
@@ -33,8 +78,15 @@ type RoomSimpleInfo struct {
 	RoomName  string               `field:"roomName"  json:"roomName"`
 	RoomType  string               `field:"roomType"  json:"roomType"`
 	JoinUser  *JoinUsersSimpleInfo `field:"joinUser"  json:"joinUser"`
+	SOSPostID *uuid.UUID           `field:"sosPostID" json:"sosPostId,omitempty"`
+	ImageURL  *string              `field:"imageURL"  json:"imageUrl,omitempty"`
 	CreatedAt time.Time            `field:"createdAt" json:"createdAt"`
 	UpdatedAt time.Time            `field:"updatedAt" json:"updatedAt"`
+
+	// 채팅방 목록 조회 시에만 채워진다.
+	UnreadCount       int64      `field:"unreadCount"       json:"unreadCount"`
+	LastReadMessageID *uuid.UUID `field:"lastReadMessageID" json:"lastReadMessageId,omitempty"`
+	LastMessage       *Message   `field:"lastMessage"       json:"lastMessage,omitempty"`
 }
 
 type JoinUsersSimpleInfo struct {
@@ -46,6 +98,7 @@ type JoinUsersSimpleInfo struct {
 type JoinRoom struct {
 	UserID   uuid.UUID
 	RoomID   uuid.UUID
+	Role     Role
 	JoinedAt time.Time
 }
 
@@ -60,12 +113,21 @@ type UserChatRoomMessageView struct {
 }
 
 type Message struct {
-	ID          uuid.UUID `field:"id"          json:"id"`
-	UserID      uuid.UUID `field:"userID"      json:"userId"`
-	RoomID      uuid.UUID `field:"roomID"      json:"roomId"`
-	MessageType string    `field:"messageType" json:"messageType"`
-	Content     string    `field:"content"     json:"content"`
-	CreatedAt   time.Time `field:"createdAt"   json:"createdAt"`
+	ID          uuid.UUID      `field:"id"          json:"id"`
+	UserID      uuid.UUID      `field:"userID"      json:"userId"`
+	RoomID      uuid.UUID      `field:"roomID"      json:"roomId"`
+	MessageType string         `field:"messageType" json:"messageType"`
+	Content     string         `field:"content"     json:"content"`
+	Medias      media.ListView `field:"medias"      json:"medias,omitempty"`
+	ReplyToID   *uuid.UUID     `field:"replyToID"   json:"replyToId,omitempty"`
+	IsDeleted   bool           `field:"isDeleted"   json:"isDeleted"`
+	CreatedAt   time.Time      `field:"createdAt"   json:"createdAt"`
+	UpdatedAt   time.Time      `field:"updatedAt"   json:"updatedAt"`
+}
+
+// IsEditableBy 작성자만 작성 후 MessageEditableDuration 이내에 메시지를 수정하거나 삭제할 수 있다.
+func (m *Message) IsEditableBy(userID uuid.UUID, now time.Time) bool {
+	return m.UserID == userID && !m.IsDeleted && now.Sub(m.CreatedAt) <= MessageEditableDuration
 }
 
 type MessageCursorView struct {
@@ -75,3 +137,53 @@ type MessageCursorView struct {
 	PrevID  *uuid.UUID `field:"prevID"  json:"prev,omitempty"`
 	Items   *[]Message `field:"items"   json:"items,omitempty"`
 }
+
+// MessageSearchHit 검색어가 포함된 메시지와 검색어 주변 내용
+type MessageSearchHit struct {
+	Message
+	Snippet string `field:"snippet" json:"snippet"`
+}
+
+// MessageSearchView 메시지 검색 결과. 최신 메시지부터 정렬된다.
+type MessageSearchView struct {
+	HasNext bool               `field:"hasNext" json:"hasNext"`
+	NextID  *uuid.UUID         `field:"nextID"  json:"next,omitempty"`
+	HasPrev bool               `field:"hasPrev" json:"hasPrev"`
+	PrevID  *uuid.UUID         `field:"prevID"  json:"prev,omitempty"`
+	Items   []MessageSearchHit `field:"items"   json:"items"`
+}
+
+// ReadReceipt 사용자가 채팅방에서 마지막으로 읽은 메시지
+type ReadReceipt struct {
+	RoomID            uuid.UUID `field:"roomID"            json:"roomId"`
+	UserID            uuid.UUID `field:"userID"            json:"userId"`
+	LastReadMessageID uuid.UUID `field:"lastReadMessageID" json:"lastReadMessageId"`
+}
+
+// OnlineMembersView 채팅방 참여자 중 현재 접속 중인 사용자 목록
+type OnlineMembersView struct {
+	RoomID  uuid.UUID   `field:"roomID"  json:"roomId"`
+	UserIDs []uuid.UUID `field:"userIDs" json:"userIds"`
+}
+
+// RoomMemberView 채팅방 참여자 정보
+type RoomMemberView struct {
+	UserID          uuid.UUID `field:"userID"          json:"userId"`
+	Nickname        string    `field:"nickname"        json:"nickname"`
+	ProfileImageURL *string   `field:"profileImageURL" json:"profileImageUrl"`
+	Role            Role      `field:"role"            json:"role"`
+	JoinedAt        time.Time `field:"joinedAt"        json:"joinedAt"`
+}
+
+// RoomMembersView 채팅방에 현재 참여중인 사용자 목록
+type RoomMembersView struct {
+	RoomID uuid.UUID        `field:"roomID" json:"roomId"`
+	Items  []RoomMemberView `field:"items"  json:"items"`
+}
+
+// DirectRoomKey 두 사용자 사이의 1:1 채팅방을 식별하는 키. 사용자 순서와 관계없이 같은 값을 반환한다.
+func DirectRoomKey(userID, otherUserID uuid.UUID) string {
+	ids := []string{userID.String(), otherUserID.String()}
+	slices.Sort(ids)
+	return ids[0] + ":" + ids[1]
+}
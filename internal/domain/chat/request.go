@@ -1,6 +1,39 @@
 package chat
 
+import "github.com/google/uuid"
+
 type CreateRoomRequest struct {
-	RoomName string `json:"roomName" validate:"required"`
+	RoomName string `json:"roomName"`
 	RoomType string `json:"roomType" validate:"required"`
+	// 1:1 채팅방인 경우 상대방 사용자 ID 하나를 전달한다.
+	ParticipantIDs []uuid.UUID   `json:"participantIds"`
+	SOSPostID      uuid.NullUUID `json:"sosPostId"`
+}
+
+// WriteMessageRequest 채팅 메시지 저장 요청
+// 같은 사용자가 같은 ClientMessageID로 다시 보내면 새로 저장하지 않고 기존 메시지를 반환한다.
+type WriteMessageRequest struct {
+	RoomID          uuid.UUID
+	UserID          uuid.UUID
+	ClientMessageID string
+	MessageType     string
+	Content         string
+	MediaIDs        []uuid.UUID
+	ReplyToID       uuid.NullUUID
+}
+
+type ReadRoomRequest struct {
+	MessageID uuid.UUID `json:"messageId" validate:"required"`
+}
+
+// UpdateRoomRequest 채팅방 정보 수정 요청. 전달하지 않은 값은 변경하지 않는다.
+type UpdateRoomRequest struct {
+	RoomName *string       `json:"roomName" validate:"omitempty,min=1,max=255"`
+	ImageID  uuid.NullUUID `json:"imageId"`
+	// 채팅방 이미지를 지운다. ImageID와 함께 전달할 수 없다.
+	RemoveImage bool `json:"removeImage"`
+}
+
+type UpdateMemberRoleRequest struct {
+	Role Role `json:"role" validate:"required,oneof=admin member"`
 }
@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+)
+
+// NormalizeSearchKeyword 검색어 앞뒤 공백을 제거하고 길이를 검증한다.
+func NormalizeSearchKeyword(keyword string) (string, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return "", pnd.ErrInvalidQuery(errors.New("expected non-empty string for query: q"))
+	}
+	if utf8.RuneCountInString(keyword) > MaxSearchKeywordLength {
+		return "", pnd.ErrInvalidQuery(
+			fmt.Errorf("search keyword must be at most %d characters", MaxSearchKeywordLength),
+		)
+	}
+	return keyword, nil
+}
+
+// SearchSnippet 본문에서 검색어가 처음 나타나는 위치의 앞뒤 SearchSnippetRadius 글자를 잘라 반환한다.
+// 잘린 부분은 말줄임표로 표시하고, 검색어를 찾지 못하면 본문 앞부분을 반환한다.
+func SearchSnippet(content, keyword string) string {
+	runes := []rune(content)
+	start := indexFoldRunes(runes, []rune(keyword))
+	if start < 0 {
+		start = 0
+	}
+	end := min(start+utf8.RuneCountInString(keyword)+SearchSnippetRadius, len(runes))
+	start = max(start-SearchSnippetRadius, 0)
+
+	snippet := string(runes[start:end])
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+// indexFoldRunes 대소문자를 구분하지 않고 needle이 처음 나타나는 rune 위치를 반환한다.
+func indexFoldRunes(haystack, needle []rune) int {
+	if len(needle) == 0 {
+		return 0
+	}
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		matched := true
+		for j, r := range needle {
+			if unicode.ToLower(haystack[i+j]) != unicode.ToLower(r) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i
+		}
+	}
+	return -1
+}
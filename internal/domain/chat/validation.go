@@ -1,14 +1,76 @@
 package chat
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+)
 
 // Validate to validate CreateRoomRequest
 func (r CreateRoomRequest) RoomTypeValidate() error {
 	// RoomType이 Model에 정의된 값인지 확인
 	switch r.RoomType {
 	case EventRoomType:
+		if r.RoomName == "" {
+			return errors.New("room name is required")
+		}
+		return nil
+	case DirectRoomType:
+		if len(r.ParticipantIDs) != 1 {
+			return errors.New("direct room requires exactly one participant")
+		}
 		return nil
 	default:
 		return errors.New("invalid room type. please check room type")
 	}
 }
+
+// Validate 메시지 종류별로 본문과 첨부 미디어를 검증한다.
+func (r *WriteMessageRequest) Validate() error {
+	if utf8.RuneCountInString(r.ClientMessageID) > MaxClientMessageIDLength {
+		return pnd.ErrInvalidMessage(
+			fmt.Errorf("client message id must be at most %d characters", MaxClientMessageIDLength),
+		)
+	}
+
+	switch r.MessageType {
+	case PlainMessage:
+		if len(r.MediaIDs) > 0 {
+			return pnd.ErrInvalidMessage(errors.New("plain message cannot have medias"))
+		}
+		return ValidateMessageContent(r.Content)
+	case MediaMessage:
+		if len(r.MediaIDs) == 0 {
+			return pnd.ErrInvalidMessage(errors.New("media message requires at least one media"))
+		}
+		if len(r.MediaIDs) > MaxMediasPerMessage {
+			return pnd.ErrInvalidMessage(
+				fmt.Errorf("media message can have at most %d medias", MaxMediasPerMessage),
+			)
+		}
+		if utf8.RuneCountInString(r.Content) > MaxMessageLength {
+			return pnd.ErrMessageTooLong(
+				fmt.Errorf("message must be at most %d characters", MaxMessageLength),
+			)
+		}
+		return nil
+	default:
+		return pnd.ErrInvalidMessageType(fmt.Errorf("invalid message type: %s", r.MessageType))
+	}
+}
+
+// ValidateMessageContent 텍스트 메시지 본문은 비어 있지 않고 최대 길이를 넘지 않아야 한다.
+func ValidateMessageContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return pnd.ErrInvalidMessage(errors.New("message must not be empty"))
+	}
+	if utf8.RuneCountInString(content) > MaxMessageLength {
+		return pnd.ErrMessageTooLong(
+			fmt.Errorf("message must be at most %d characters", MaxMessageLength),
+		)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package chat
 
 import (
 	"github.com/google/uuid"
+	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
 )
 
@@ -28,6 +30,7 @@ func ToJoinRoom(row databasegen.JoinRoomRow) *JoinRoom {
 	return &JoinRoom{
 		UserID:   row.UserID,
 		RoomID:   row.RoomID,
+		Role:     Role(row.Role),
 		JoinedAt: row.JoinedAt,
 	}
 }
@@ -46,11 +49,25 @@ func ToUserChatRoomsView(
 	roomSimpleInfos := make([]RoomSimpleInfo, len(rows))
 	for i, r := range rows {
 		roomSimpleInfos[i] = RoomSimpleInfo{
-			ID:        r.ChatRoomID,
-			RoomName:  r.ChatRoomName,
-			RoomType:  r.ChatRoomType,
-			CreatedAt: r.ChatRoomCreatedAt,
-			UpdatedAt: r.ChatRoomUpdatedAt,
+			ID:          r.ChatRoomID,
+			RoomName:    r.ChatRoomName,
+			RoomType:    r.ChatRoomType,
+			CreatedAt:   r.ChatRoomCreatedAt,
+			UpdatedAt:   r.ChatRoomUpdatedAt,
+			UnreadCount: r.UnreadCount,
+		}
+		roomSimpleInfos[i].SOSPostID = nullUUIDToPtr(r.ChatRoomSosPostID)
+		roomSimpleInfos[i].ImageURL = utils.NullStrToStrPtr(r.ChatRoomImageUrl)
+		roomSimpleInfos[i].LastReadMessageID = nullUUIDToPtr(r.LastReadMessageID)
+		if r.LastMessageID.Valid {
+			roomSimpleInfos[i].LastMessage = &Message{
+				ID:          r.LastMessageID.UUID,
+				UserID:      r.LastMessageUserID.UUID,
+				RoomID:      r.ChatRoomID,
+				MessageType: r.LastMessageType.String,
+				Content:     r.LastMessageContent.String,
+				CreatedAt:   r.LastMessageCreatedAt.Time,
+			}
 		}
 	}
 
@@ -64,11 +81,141 @@ func ToUserChatRoomView(row databasegen.FindRoomByIDAndUserIDRow) *RoomSimpleInf
 		ID:        row.ID,
 		RoomName:  row.Name,
 		RoomType:  row.RoomType,
+		SOSPostID: nullUUIDToPtr(row.SosPostID),
+		ImageURL:  utils.NullStrToStrPtr(row.ImageUrl),
 		CreatedAt: row.CreatedAt,
 		UpdatedAt: row.UpdatedAt,
 	}
 }
 
+func ToDirectRoomView(row databasegen.FindRoomByDirectKeyRow) *RoomSimpleInfo {
+	return &RoomSimpleInfo{
+		ID:        row.ID,
+		RoomName:  row.Name,
+		RoomType:  row.RoomType,
+		SOSPostID: nullUUIDToPtr(row.SosPostID),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func ToRoomMembersView(roomID uuid.UUID, rows []databasegen.FindRoomMembersRow) *RoomMembersView {
+	items := make([]RoomMemberView, len(rows))
+	for i, row := range rows {
+		items[i] = RoomMemberView{
+			UserID:          row.ID,
+			Nickname:        row.Nickname,
+			ProfileImageURL: utils.NullStrToStrPtr(row.ProfileImageUrl),
+			Role:            Role(row.Role),
+			JoinedAt:        row.JoinedAt,
+		}
+	}
+
+	return &RoomMembersView{
+		RoomID: roomID,
+		Items:  items,
+	}
+}
+
+func nullUUIDToPtr(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	return &id.UUID
+}
+
+func ToReadReceipt(row databasegen.UpdateLastReadMessageRow) *ReadReceipt {
+	return &ReadReceipt{
+		RoomID:            row.RoomID,
+		UserID:            row.UserID,
+		LastReadMessageID: row.LastReadMessageID.UUID,
+	}
+}
+
+func ToMessageFromWriteRow(row databasegen.WriteMessageRow) *Message {
+	return &Message{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		RoomID:      row.RoomID,
+		MessageType: row.MessageType,
+		Content:     row.Content,
+		ReplyToID:   nullUUIDToPtr(row.ReplyToID),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func ToMessageFromUpdateRow(row databasegen.UpdateMessageContentRow) *Message {
+	return &Message{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		RoomID:      row.RoomID,
+		MessageType: row.MessageType,
+		Content:     row.Content,
+		ReplyToID:   nullUUIDToPtr(row.ReplyToID),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// ToMessage 삭제된 메시지는 내용 없이 반환한다.
+func ToMessage(row databasegen.ChatMessage) *Message {
+	message := &Message{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		RoomID:      row.RoomID,
+		MessageType: row.MessageType,
+		Content:     row.Content,
+		ReplyToID:   nullUUIDToPtr(row.ReplyToID),
+		IsDeleted:   row.DeletedAt.Valid,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if message.IsDeleted {
+		message.Content = ""
+	}
+	return message
+}
+
+// MediaMessageIDs 미디어 메시지의 ID 목록을 반환한다.
+func (v *MessageCursorView) MediaMessageIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0)
+	if v.Items == nil {
+		return ids
+	}
+
+	for _, m := range *v.Items {
+		// 삭제된 메시지의 미디어는 노출하지 않는다.
+		if m.MessageType == MediaMessage && !m.IsDeleted {
+			ids = append(ids, m.ID)
+		}
+	}
+	return ids
+}
+
+// AttachMedias 메시지에 연결된 미디어를 각 메시지에 채워 넣는다.
+func (v *MessageCursorView) AttachMedias(rows []databasegen.FindResourceMediaByResourceIDsRow) {
+	if v.Items == nil || len(rows) == 0 {
+		return
+	}
+
+	mediasByMessageID := make(map[uuid.UUID]media.ListView)
+	for _, r := range rows {
+		mediasByMessageID[r.ResourceID] = append(mediasByMessageID[r.ResourceID], &media.DetailView{
+			ID:        r.MediaID,
+			MediaType: media.Type(r.MediaType),
+			URL:       r.Url,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	for i, m := range *v.Items {
+		if medias, ok := mediasByMessageID[m.ID]; ok {
+			(*v.Items)[i].Medias = medias
+		}
+	}
+}
+
 func createMessageCursorView(
 	row interface{},
 	hasNext, hasPrev bool,
@@ -86,7 +233,10 @@ func createMessageCursorView(
 				RoomID:      r.RoomID,
 				MessageType: r.MessageType,
 				Content:     r.Content,
+				ReplyToID:   nullUUIDToPtr(r.ReplyToID),
+				IsDeleted:   r.DeletedAt.Valid,
 				CreatedAt:   r.CreatedAt,
+				UpdatedAt:   r.UpdatedAt,
 			}
 		}
 	case []databasegen.FindPrevMessageByRoomIDRow:
@@ -98,7 +248,10 @@ func createMessageCursorView(
 				RoomID:      r.RoomID,
 				MessageType: r.MessageType,
 				Content:     r.Content,
+				ReplyToID:   nullUUIDToPtr(r.ReplyToID),
+				IsDeleted:   r.DeletedAt.Valid,
 				CreatedAt:   r.CreatedAt,
+				UpdatedAt:   r.UpdatedAt,
 			}
 		}
 	case []databasegen.FindNextMessageByRoomIDRow:
@@ -110,7 +263,10 @@ func createMessageCursorView(
 				RoomID:      r.RoomID,
 				MessageType: r.MessageType,
 				Content:     r.Content,
+				ReplyToID:   nullUUIDToPtr(r.ReplyToID),
+				IsDeleted:   r.DeletedAt.Valid,
 				CreatedAt:   r.CreatedAt,
+				UpdatedAt:   r.UpdatedAt,
 			}
 		}
 	case []databasegen.FindMessagesByRoomIDAndSizeRow:
@@ -122,7 +278,10 @@ func createMessageCursorView(
 				RoomID:      r.RoomID,
 				MessageType: r.MessageType,
 				Content:     r.Content,
+				ReplyToID:   nullUUIDToPtr(r.ReplyToID),
+				IsDeleted:   r.DeletedAt.Valid,
 				CreatedAt:   r.CreatedAt,
+				UpdatedAt:   r.UpdatedAt,
 			}
 		}
 	default:
@@ -179,3 +338,40 @@ func ToUserChatRoomMessageView(
 ) *MessageCursorView {
 	return createMessageCursorView(row, hasNext, hasPrev, nextMessageID, prevMessageID)
 }
+
+func ToMessageSearchView(
+	rows []databasegen.SearchMessagesByRoomIDRow,
+	keyword string,
+	hasNext, hasPrev bool,
+) *MessageSearchView {
+	view := &MessageSearchView{
+		HasNext: hasNext,
+		HasPrev: hasPrev,
+		Items:   make([]MessageSearchHit, len(rows)),
+	}
+	for i, row := range rows {
+		view.Items[i] = MessageSearchHit{
+			Message: Message{
+				ID:          row.ID,
+				UserID:      row.UserID,
+				RoomID:      row.RoomID,
+				MessageType: row.MessageType,
+				Content:     row.Content,
+				ReplyToID:   nullUUIDToPtr(row.ReplyToID),
+				CreatedAt:   row.CreatedAt,
+				UpdatedAt:   row.UpdatedAt,
+			},
+			Snippet: SearchSnippet(row.Content, keyword),
+		}
+	}
+
+	if len(rows) > 0 {
+		if hasNext {
+			view.NextID = &view.Items[0].ID
+		}
+		if hasPrev {
+			view.PrevID = &view.Items[len(rows)-1].ID
+		}
+	}
+	return view
+}
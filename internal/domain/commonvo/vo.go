@@ -1,5 +1,10 @@
 package commonvo
 
+import (
+	"database/sql"
+	"math"
+)
+
 // Pet
 type PetType string
 
@@ -11,3 +16,43 @@ const (
 func (p *PetType) String() string {
 	return string(*p)
 }
+
+// Location 위도, 경도 좌표
+type Location struct {
+	Latitude  float64 `json:"latitude"  validate:"min=-90,max=90"`
+	Longitude float64 `json:"longitude" validate:"min=-180,max=180"`
+}
+
+const earthRadiusKm = 6371
+
+// 위도, 경도 중 하나라도 없으면 nil을 반환한다.
+func NewLocation(latitude, longitude sql.NullFloat64) *Location {
+	if !latitude.Valid || !longitude.Valid {
+		return nil
+	}
+	return &Location{Latitude: latitude.Float64, Longitude: longitude.Float64}
+}
+
+func (l *Location) NullLatitude() sql.NullFloat64 {
+	if l == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: l.Latitude, Valid: true}
+}
+
+func (l *Location) NullLongitude() sql.NullFloat64 {
+	if l == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: l.Longitude, Valid: true}
+}
+
+// 두 좌표 사이의 거리(km)를 하버사인 공식으로 계산한다. DB의 distance_km 함수와 같은 값을 반환한다.
+func (l *Location) DistanceKm(other *Location) float64 {
+	toRadians := func(degree float64) float64 { return degree * math.Pi / 180 }
+	dLat := toRadians(other.Latitude - l.Latitude)
+	dLng := toRadians(other.Longitude - l.Longitude)
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(toRadians(l.Latitude))*math.Cos(toRadians(other.Latitude))*math.Pow(math.Sin(dLng/2), 2)
+	return earthRadiusKm * 2 * math.Asin(math.Min(1, math.Sqrt(a)))
+}
@@ -0,0 +1,13 @@
+package notification
+
+type Platform string
+
+const (
+	PlatformIOS     Platform = "ios"
+	PlatformAndroid Platform = "android"
+	PlatformWeb     Platform = "web"
+)
+
+func (p Platform) String() string {
+	return string(p)
+}
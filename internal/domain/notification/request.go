@@ -0,0 +1,25 @@
+package notification
+
+import (
+	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type RegisterDeviceTokenRequest struct {
+	Token    string   `json:"token"    validate:"required,max=512"`
+	Platform Platform `json:"platform" validate:"required,oneof=ios android web"`
+}
+
+func (r *RegisterDeviceTokenRequest) ToDBParams(userID uuid.UUID) databasegen.UpsertDeviceTokenParams {
+	return databasegen.UpsertDeviceTokenParams{
+		ID:       datatype.NewUUIDV7(),
+		UserID:   userID,
+		Token:    r.Token,
+		Platform: r.Platform.String(),
+	}
+}
+
+type DeleteDeviceTokenRequest struct {
+	Token string `json:"token" validate:"required"`
+}
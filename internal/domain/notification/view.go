@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type DeviceTokenView struct {
+	ID        uuid.UUID `json:"id"`
+	Token     string    `json:"token"`
+	Platform  Platform  `json:"platform"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func ToDeviceTokenView(row databasegen.UserDeviceToken) *DeviceTokenView {
+	return &DeviceTokenView{
+		ID:        row.ID,
+		Token:     row.Token,
+		Platform:  Platform(row.Platform),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package report
+
+type TargetType string
+
+const (
+	TargetTypeUser        TargetType = "user"
+	TargetTypeChatMessage TargetType = "chat_message"
+	TargetTypeSOSPost     TargetType = "sos_post"
+)
+
+func (t TargetType) String() string {
+	return string(t)
+}
+
+type Reason string
+
+const (
+	ReasonSpam          Reason = "spam"
+	ReasonAbuse         Reason = "abuse"
+	ReasonInappropriate Reason = "inappropriate"
+	ReasonFraud         Reason = "fraud"
+	ReasonOther         Reason = "other"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusResolved Status = "resolved"
+	StatusRejected Status = "rejected"
+)
@@ -0,0 +1,27 @@
+package report
+
+import (
+	"github.com/google/uuid"
+	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type CreateReportRequest struct {
+	TargetType  TargetType `json:"targetType"  validate:"required,oneof=user chat_message sos_post"`
+	TargetID    uuid.UUID  `json:"targetId"    validate:"required"`
+	Reason      Reason     `json:"reason"      validate:"required,oneof=spam abuse inappropriate fraud other"`
+	Description string     `json:"description" validate:"max=1000"`
+}
+
+func (r *CreateReportRequest) ToDBParams(reporterID, reportedUserID uuid.UUID) databasegen.CreateReportParams {
+	return databasegen.CreateReportParams{
+		ID:             datatype.NewUUIDV7(),
+		ReporterID:     reporterID,
+		ReportedUserID: reportedUserID,
+		TargetType:     r.TargetType.String(),
+		TargetID:       r.TargetID,
+		Reason:         r.Reason.String(),
+		Description:    utils.StrToNullStr(r.Description),
+	}
+}
@@ -0,0 +1,33 @@
+package report
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type DetailView struct {
+	ID             uuid.UUID  `json:"id"`
+	ReportedUserID uuid.UUID  `json:"reportedUserId"`
+	TargetType     TargetType `json:"targetType"`
+	TargetID       uuid.UUID  `json:"targetId"`
+	Reason         Reason     `json:"reason"`
+	Description    *string    `json:"description,omitempty"`
+	Status         Status     `json:"status"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+func ToDetailView(row databasegen.Report) *DetailView {
+	return &DetailView{
+		ID:             row.ID,
+		ReportedUserID: row.ReportedUserID,
+		TargetType:     TargetType(row.TargetType),
+		TargetID:       row.TargetID,
+		Reason:         Reason(row.Reason),
+		Description:    utils.NullStrToStrPtr(row.Description),
+		Status:         Status(row.Status),
+		CreatedAt:      row.CreatedAt,
+	}
+}
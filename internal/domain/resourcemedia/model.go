@@ -3,7 +3,8 @@ package resourcemedia
 type ResourceType string
 
 const (
-	SOSResourceType ResourceType = "sos_posts"
+	SOSResourceType         ResourceType = "sos_posts"
+	ChatMessageResourceType ResourceType = "chat_messages"
 )
 
 func (r ResourceType) String() string {
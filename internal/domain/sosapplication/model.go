@@ -0,0 +1,14 @@
+package sosapplication
+
+// Status 돌봄급구 게시글 지원 상태
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
@@ -0,0 +1,20 @@
+package sosapplication
+
+import (
+	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type ApplyRequest struct {
+	Message string `json:"message" validate:"required,max=1000"`
+}
+
+func (r *ApplyRequest) ToDBParams(sosPostID, applicantID uuid.UUID) databasegen.CreateSOSPostApplicationParams {
+	return databasegen.CreateSOSPostApplicationParams{
+		ID:          datatype.NewUUIDV7(),
+		SosPostID:   sosPostID,
+		ApplicantID: applicantID,
+		Message:     r.Message,
+	}
+}
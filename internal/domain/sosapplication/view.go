@@ -0,0 +1,76 @@
+package sosapplication
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/user"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type DetailView struct {
+	ID          uuid.UUID `json:"id"`
+	SOSPostID   uuid.UUID `json:"sosPostId"`
+	ApplicantID uuid.UUID `json:"applicantId"`
+	Message     string    `json:"message"`
+	Status      Status    `json:"status"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func ToDetailView(row databasegen.SosPostApplication) *DetailView {
+	return &DetailView{
+		ID:          row.ID,
+		SOSPostID:   row.SosPostID,
+		ApplicantID: row.ApplicantID,
+		Message:     row.Message,
+		Status:      Status(row.Status),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+type ApplicantView struct {
+	ID        uuid.UUID                `json:"id"`
+	Applicant *user.WithoutPrivateInfo `json:"applicant"`
+	Message   string                   `json:"message"`
+	Status    Status                   `json:"status"`
+	CreatedAt time.Time                `json:"createdAt"`
+	UpdatedAt time.Time                `json:"updatedAt"`
+}
+
+type ListView struct {
+	SOSPostID uuid.UUID       `json:"sosPostId"`
+	Items     []ApplicantView `json:"items"`
+}
+
+func ToListView(sosPostID uuid.UUID, rows []databasegen.FindSOSPostApplicationsBySOSPostIDRow) *ListView {
+	items := make([]ApplicantView, len(rows))
+	for i, row := range rows {
+		items[i] = ApplicantView{
+			ID: row.ID,
+			Applicant: &user.WithoutPrivateInfo{
+				ID:              row.ApplicantID,
+				Nickname:        row.Nickname,
+				ProfileImageURL: utils.NullStrToStrPtr(row.ProfileImageUrl),
+			},
+			Message:   row.Message,
+			Status:    Status(row.Status),
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		}
+	}
+
+	return &ListView{
+		SOSPostID: sosPostID,
+		Items:     items,
+	}
+}
+
+// AcceptView 지원 수락 결과. 게시글 작성자와 돌보미 사이의 1:1 채팅방을 함께 반환한다.
+type AcceptView struct {
+	Application *DetailView          `json:"application"`
+	ChatRoom    *chat.RoomSimpleInfo `json:"chatRoom"`
+}
@@ -0,0 +1,89 @@
+package sospost
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+)
+
+// Cursor 돌봄급구 게시글 목록에서 마지막으로 조회한 게시글의 정렬 키.
+// 클라이언트에는 Encode로 인코딩한 불투명한 문자열로 전달한다.
+type Cursor struct {
+	SortBy string    `json:"s"`
+	ID     uuid.UUID `json:"i"`
+	// 최신순 정렬에서 사용한다.
+	CreatedAt *time.Time `json:"c,omitempty"`
+	// 마감순 정렬에서 사용한다. 가장 이른 돌봄 시작 시각이며, 돌봄 날짜가 없는 게시글이면 nil이다.
+	StartAt *time.Time `json:"t,omitempty"`
+}
+
+// SupportsCursor 커서 기반 페이지네이션을 지원하는 정렬 기준인지 확인한다.
+func SupportsCursor(sortBy string) bool {
+	return sortBy == SortByNewest || sortBy == SortByDeadline
+}
+
+// NewCursor 게시글을 정렬 기준에 맞는 커서로 변환한다. 커서를 지원하지 않는 정렬 기준이면 nil을 반환한다.
+func NewCursor(sortBy string, post *SOSPostInfo) *Cursor {
+	switch sortBy {
+	case SortByNewest:
+		return &Cursor{SortBy: sortBy, ID: post.ID, CreatedAt: &post.CreatedAt}
+	case SortByDeadline:
+		return &Cursor{SortBy: sortBy, ID: post.ID, StartAt: post.Dates.EarliestStartAt()}
+	default:
+		return nil
+	}
+}
+
+// NullValues 커서를 조회 조건으로 변환한다. 커서가 nil이면 모두 NULL을 반환한다.
+func (c *Cursor) NullValues() (id uuid.NullUUID, createdAt, startAt sql.NullTime) {
+	if c == nil {
+		return id, createdAt, startAt
+	}
+
+	id = uuid.NullUUID{UUID: c.ID, Valid: true}
+	if c.CreatedAt != nil {
+		createdAt = sql.NullTime{Time: *c.CreatedAt, Valid: true}
+	}
+	if c.StartAt != nil {
+		startAt = sql.NullTime{Time: *c.StartAt, Valid: true}
+	}
+	return id, createdAt, startAt
+}
+
+func (c *Cursor) Encode() string {
+	payload, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// DecodeCursor 클라이언트가 전달한 커서 문자열을 해석한다.
+func DecodeCursor(encoded string) (*Cursor, error) {
+	invalidCursorErr := pnd.ErrInvalidQuery(errors.New("expected valid cursor for query: cursor"))
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalidCursorErr
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, invalidCursorErr
+	}
+
+	switch cursor.SortBy {
+	case SortByNewest:
+		if cursor.CreatedAt == nil {
+			return nil, invalidCursorErr
+		}
+	case SortByDeadline:
+		// 돌봄 날짜가 없는 게시글의 커서는 시작 시각이 없다.
+	default:
+		return nil, invalidCursorErr
+	}
+
+	return &cursor, nil
+}
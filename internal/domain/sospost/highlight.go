@@ -0,0 +1,97 @@
+package sospost
+
+import "unicode"
+
+// 내용 하이라이트에서 검색어 앞뒤로 보여줄 최대 글자 수
+const highlightContextLength = 40
+
+// Highlight 검색어와 일치하는 부분을 표시한 제목과 내용 일부
+type Highlight struct {
+	Title   []HighlightSegment `json:"title"`
+	Content []HighlightSegment `json:"content"`
+}
+
+// HighlightSegment 하이라이트를 구성하는 문자열 조각. Matched가 true이면 검색어와 일치하는 부분이다.
+type HighlightSegment struct {
+	Text    string `json:"text"`
+	Matched bool   `json:"matched"`
+}
+
+// NewHighlight 제목 전체와 내용 중 처음으로 검색어가 나타나는 부분 주변을 대소문자 구분 없이 하이라이트한다.
+func NewHighlight(title, content, keyword string) *Highlight {
+	keywordRunes := toLowerRunes([]rune(keyword))
+	if len(keywordRunes) == 0 {
+		return nil
+	}
+
+	titleRunes := []rune(title)
+	contentRunes := []rune(content)
+	lowerContent := toLowerRunes(contentRunes)
+
+	// 내용에 검색어가 없으면 내용의 앞부분을 보여준다.
+	start, end := 0, min(len(contentRunes), highlightContextLength*2)
+	if index := indexRunes(lowerContent, keywordRunes, 0); index >= 0 {
+		start = max(0, index-highlightContextLength)
+		end = min(len(contentRunes), index+len(keywordRunes)+highlightContextLength)
+	}
+
+	contentSegments := splitByKeyword(contentRunes[start:end], lowerContent[start:end], keywordRunes)
+	if start > 0 {
+		contentSegments = append([]HighlightSegment{{Text: "…"}}, contentSegments...)
+	}
+	if end < len(contentRunes) {
+		contentSegments = append(contentSegments, HighlightSegment{Text: "…"})
+	}
+
+	return &Highlight{
+		Title:   splitByKeyword(titleRunes, toLowerRunes(titleRunes), keywordRunes),
+		Content: contentSegments,
+	}
+}
+
+// 원문을 검색어와 일치하는 조각과 일치하지 않는 조각으로 나눈다.
+func splitByKeyword(text, lowerText, keyword []rune) []HighlightSegment {
+	segments := make([]HighlightSegment, 0)
+	from := 0
+	for from < len(text) {
+		index := indexRunes(lowerText, keyword, from)
+		if index < 0 {
+			break
+		}
+		if index > from {
+			segments = append(segments, HighlightSegment{Text: string(text[from:index])})
+		}
+		segments = append(segments, HighlightSegment{Text: string(text[index : index+len(keyword)]), Matched: true})
+		from = index + len(keyword)
+	}
+	if from < len(text) {
+		segments = append(segments, HighlightSegment{Text: string(text[from:])})
+	}
+
+	return segments
+}
+
+// 글자 수가 바뀌지 않도록 글자 단위로 소문자로 변환한다.
+func toLowerRunes(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+func indexRunes(text, sub []rune, from int) int {
+	for i := from; i+len(sub) <= len(text); i++ {
+		matched := true
+		for j := range sub {
+			if text[i+j] != sub[j] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i
+		}
+	}
+	return -1
+}
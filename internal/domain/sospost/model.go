@@ -3,6 +3,7 @@ package sospost
 import (
 	"encoding/json"
 	"log"
+	"slices"
 	"time"
 
 	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
@@ -13,6 +14,7 @@ import (
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/soscondition"
 
 	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/pet"
 )
@@ -21,6 +23,7 @@ type (
 	CareType    string
 	CarerGender string
 	RewardType  string
+	Status      string
 )
 
 const (
@@ -40,6 +43,20 @@ const (
 	RewardTypeNegotiable RewardType = "negotiable"
 )
 
+// 게시글 상태. 돌봄이 구해지면 matched, 돌봄이 끝나면 completed, 더 이상 구하지 않으면 cancelled가 된다.
+const (
+	StatusOpen      Status = "open"
+	StatusMatched   Status = "matched"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+)
+
+// 각 상태에서 변경할 수 있는 다음 상태
+var statusTransitions = map[Status][]Status{
+	StatusOpen:    {StatusMatched, StatusCancelled},
+	StatusMatched: {StatusCompleted, StatusCancelled},
+}
+
 const (
 	JSONNullString = "null"
 	JSONEmptyArray = "[]"
@@ -57,6 +74,20 @@ func (r *RewardType) String() string {
 	return string(*r)
 }
 
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusOpen, StatusMatched, StatusCompleted, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo 현재 상태에서 next 상태로 변경할 수 있는지 여부
+func (s Status) CanTransitionTo(next Status) bool {
+	return slices.Contains(statusTransitions[s], next)
+}
+
 type SOSPost struct {
 	ID          uuid.UUID     `field:"id"`
 	AuthorID    uuid.UUID     `field:"author_id"`
@@ -77,22 +108,42 @@ type SOSPostList struct {
 }
 
 type SOSPostInfo struct {
-	ID          uuid.UUID                       `field:"id"          json:"id"`
-	AuthorID    uuid.UUID                       `field:"author"      json:"author"`
-	Title       string                          `field:"title"       json:"title"`
-	Content     string                          `field:"content"     json:"content"`
-	Media       media.ViewListForSOSPost        `field:"media"       json:"media"`
-	Conditions  soscondition.ViewListForSOSPost `field:"conditions"  json:"conditions"`
-	Pets        pet.ViewListForSOSPost          `field:"pets"        json:"pets"`
-	Reward      string                          `field:"reward"      json:"reward"`
-	Dates       SOSDatesList                    `field:"dates"       json:"dates"`
-	CareType    CareType                        `field:"careType"    json:"careType"`
-	CarerGender CarerGender                     `field:"carerGender" json:"carerGender"`
-	RewardType  RewardType                      `field:"rewardType"  json:"rewardType"`
-	ThumbnailID uuid.NullUUID                   `field:"thumbnailId" json:"thumbnailId"`
-	CreatedAt   time.Time                       `field:"createdAt"   json:"createdAt"`
-	UpdatedAt   time.Time                       `field:"updatedAt"   json:"updatedAt"`
-	DeletedAt   time.Time                       `field:"deletedAt"   json:"deletedAt"`
+	ID           uuid.UUID                       `field:"id"           json:"id"`
+	AuthorID     uuid.UUID                       `field:"author"       json:"author"`
+	Title        string                          `field:"title"        json:"title"`
+	Content      string                          `field:"content"      json:"content"`
+	Media        media.ViewListForSOSPost        `field:"media"        json:"media"`
+	Conditions   soscondition.ViewListForSOSPost `field:"conditions"   json:"conditions"`
+	Pets         pet.ViewListForSOSPost          `field:"pets"         json:"pets"`
+	Reward       string                          `field:"reward"       json:"reward"`
+	Dates        SOSDatesList                    `field:"dates"        json:"dates"`
+	CareType     CareType                        `field:"careType"     json:"careType"`
+	CarerGender  CarerGender                     `field:"carerGender"  json:"carerGender"`
+	RewardType   RewardType                      `field:"rewardType"   json:"rewardType"`
+	RewardDetail *RewardDetail                   `field:"rewardDetail" json:"rewardDetail"`
+	ThumbnailID  uuid.NullUUID                   `field:"thumbnailId"  json:"thumbnailId"`
+	Status       Status                          `field:"status"       json:"status"`
+	Region       *string                         `field:"region"       json:"region"`
+	Location     *commonvo.Location              `field:"location"     json:"location"`
+	CreatedAt    time.Time                       `field:"createdAt"    json:"createdAt"`
+	UpdatedAt    time.Time                       `field:"updatedAt"    json:"updatedAt"`
+	DeletedAt    time.Time                       `field:"deletedAt"    json:"deletedAt"`
+	Counts
+}
+
+// Counts 게시글 조회수와 지원자 수, 저장 수
+type Counts struct {
+	ViewCount        int `field:"viewCount"        json:"viewCount"`
+	ApplicationCount int `field:"applicationCount" json:"applicationCount"`
+	BookmarkCount    int `field:"bookmarkCount"    json:"bookmarkCount"`
+}
+
+// ViewerKey 조회수 중복 집계를 막기 위한 조회자 식별자. 로그인하지 않은 경우 IP로 구분한다.
+func ViewerKey(viewerID uuid.NullUUID, ip string) string {
+	if viewerID.Valid {
+		return "user:" + viewerID.UUID.String()
+	}
+	return "ip:" + ip
 }
 
 type SOSPostInfoList struct {
@@ -101,21 +152,30 @@ type SOSPostInfoList struct {
 
 func ToInfoFromFindRow(row databasegen.FindSOSPostsRow) *SOSPostInfo {
 	return &SOSPostInfo{
-		ID:          row.ID,
-		AuthorID:    row.AuthorID,
-		Title:       utils.NullStrToStr(row.Title),
-		Content:     utils.NullStrToStr(row.Content),
-		Media:       ParseMediaList(row.MediaInfo.RawMessage),
-		Conditions:  ParseConditionsList(row.ConditionsInfo.RawMessage),
-		Pets:        ParsePetsList(row.PetsInfo.RawMessage),
-		Reward:      utils.NullStrToStr(row.Reward),
-		Dates:       ParseSOSDatesList(row.Dates),
-		CareType:    CareType(row.CareType.String),
-		CarerGender: CarerGender(row.CarerGender.String),
-		RewardType:  RewardType(row.RewardType.String),
-		ThumbnailID: row.ThumbnailID,
-		CreatedAt:   row.CreatedAt,
-		UpdatedAt:   row.UpdatedAt,
+		ID:           row.ID,
+		AuthorID:     row.AuthorID,
+		Title:        utils.NullStrToStr(row.Title),
+		Content:      utils.NullStrToStr(row.Content),
+		Media:        ParseMediaList(row.MediaInfo.RawMessage),
+		Conditions:   ParseConditionsList(row.ConditionsInfo.RawMessage),
+		Pets:         ParsePetsList(row.PetsInfo.RawMessage),
+		Reward:       utils.NullStrToStr(row.Reward),
+		Dates:        ParseSOSDatesList(row.Dates),
+		CareType:     CareType(row.CareType.String),
+		CarerGender:  CarerGender(row.CarerGender.String),
+		RewardType:   RewardType(row.RewardType.String),
+		RewardDetail: NewRewardDetail(row.RewardAmount, row.RewardUnit, row.GifticonDescription),
+		ThumbnailID:  row.ThumbnailID,
+		Status:       Status(row.Status),
+		Region:       utils.NullStrToStrPtr(row.Region),
+		Location:     commonvo.NewLocation(row.Latitude, row.Longitude),
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+		Counts: Counts{
+			ViewCount:        int(row.ViewCount),
+			ApplicationCount: int(row.ApplicationCount),
+			BookmarkCount:    int(row.BookmarkCount),
+		},
 	}
 }
 
@@ -130,21 +190,30 @@ func ToInfoListFromFindRow(rows []databasegen.FindSOSPostsRow, page, size int) *
 
 func ToInfoFromFindAuthorIDRow(row databasegen.FindSOSPostsByAuthorIDRow) *SOSPostInfo {
 	return &SOSPostInfo{
-		ID:          row.ID,
-		AuthorID:    row.AuthorID,
-		Title:       utils.NullStrToStr(row.Title),
-		Content:     utils.NullStrToStr(row.Content),
-		Media:       ParseMediaList(row.MediaInfo.RawMessage),
-		Conditions:  ParseConditionsList(row.ConditionsInfo.RawMessage),
-		Pets:        ParsePetsList(row.PetsInfo.RawMessage),
-		Reward:      utils.NullStrToStr(row.Reward),
-		Dates:       ParseSOSDatesList(row.Dates),
-		CareType:    CareType(row.CareType.String),
-		CarerGender: CarerGender(row.CarerGender.String),
-		RewardType:  RewardType(row.RewardType.String),
-		ThumbnailID: row.ThumbnailID,
-		CreatedAt:   row.CreatedAt,
-		UpdatedAt:   row.UpdatedAt,
+		ID:           row.ID,
+		AuthorID:     row.AuthorID,
+		Title:        utils.NullStrToStr(row.Title),
+		Content:      utils.NullStrToStr(row.Content),
+		Media:        ParseMediaList(row.MediaInfo.RawMessage),
+		Conditions:   ParseConditionsList(row.ConditionsInfo.RawMessage),
+		Pets:         ParsePetsList(row.PetsInfo.RawMessage),
+		Reward:       utils.NullStrToStr(row.Reward),
+		Dates:        ParseSOSDatesList(row.Dates),
+		CareType:     CareType(row.CareType.String),
+		CarerGender:  CarerGender(row.CarerGender.String),
+		RewardType:   RewardType(row.RewardType.String),
+		RewardDetail: NewRewardDetail(row.RewardAmount, row.RewardUnit, row.GifticonDescription),
+		ThumbnailID:  row.ThumbnailID,
+		Status:       Status(row.Status),
+		Region:       utils.NullStrToStrPtr(row.Region),
+		Location:     commonvo.NewLocation(row.Latitude, row.Longitude),
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+		Counts: Counts{
+			ViewCount:        int(row.ViewCount),
+			ApplicationCount: int(row.ApplicationCount),
+			BookmarkCount:    int(row.BookmarkCount),
+		},
 	}
 }
 
@@ -161,23 +230,46 @@ func ToInfoListFromFindAuthorIDRow(
 	return sl
 }
 
+func ToInfoListFromFindBookmarkedRow(
+	rows []databasegen.FindBookmarkedSOSPostsRow,
+	page, size int,
+) *SOSPostInfoList {
+	sl := NewSOSPostInfoList(page, size)
+	for _, row := range rows {
+		// 저장한 게시글 조회 결과는 작성자 ID로 조회한 결과와 컬럼이 같다.
+		sl.Items = append(sl.Items, *ToInfoFromFindAuthorIDRow(databasegen.FindSOSPostsByAuthorIDRow(row)))
+	}
+
+	sl.CalcLastPage()
+	return sl
+}
+
 func ToInfoFromFindByIDRow(row databasegen.FindSOSPostByIDRow) *SOSPostInfo {
 	return &SOSPostInfo{
-		ID:          row.ID,
-		AuthorID:    row.AuthorID,
-		Title:       utils.NullStrToStr(row.Title),
-		Content:     utils.NullStrToStr(row.Content),
-		Media:       ParseMediaList(row.MediaInfo.RawMessage),
-		Conditions:  ParseConditionsList(row.ConditionsInfo.RawMessage),
-		Pets:        ParsePetsList(row.PetsInfo.RawMessage),
-		Reward:      utils.NullStrToStr(row.Reward),
-		Dates:       ParseSOSDatesList(row.Dates),
-		CareType:    CareType(row.CareType.String),
-		CarerGender: CarerGender(row.CarerGender.String),
-		RewardType:  RewardType(row.RewardType.String),
-		ThumbnailID: row.ThumbnailID,
-		CreatedAt:   row.CreatedAt,
-		UpdatedAt:   row.UpdatedAt,
+		ID:           row.ID,
+		AuthorID:     row.AuthorID,
+		Title:        utils.NullStrToStr(row.Title),
+		Content:      utils.NullStrToStr(row.Content),
+		Media:        ParseMediaList(row.MediaInfo.RawMessage),
+		Conditions:   ParseConditionsList(row.ConditionsInfo.RawMessage),
+		Pets:         ParsePetsList(row.PetsInfo.RawMessage),
+		Reward:       utils.NullStrToStr(row.Reward),
+		Dates:        ParseSOSDatesList(row.Dates),
+		CareType:     CareType(row.CareType.String),
+		CarerGender:  CarerGender(row.CarerGender.String),
+		RewardType:   RewardType(row.RewardType.String),
+		RewardDetail: NewRewardDetail(row.RewardAmount, row.RewardUnit, row.GifticonDescription),
+		ThumbnailID:  row.ThumbnailID,
+		Status:       Status(row.Status),
+		Region:       utils.NullStrToStrPtr(row.Region),
+		Location:     commonvo.NewLocation(row.Latitude, row.Longitude),
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+		Counts: Counts{
+			ViewCount:        int(row.ViewCount),
+			ApplicationCount: int(row.ApplicationCount),
+			BookmarkCount:    int(row.BookmarkCount),
+		},
 	}
 }
 
@@ -251,12 +343,26 @@ func ParseSOSDatesList(rows json.RawMessage) SOSDatesList {
 }
 
 type SOSDates struct {
-	ID          uuid.UUID `field:"id"            json:"id"`
-	DateStartAt string    `field:"date_start_at" json:"date_start_at"`
-	DateEndAt   string    `field:"date_end_at"   json:"date_end_at"`
-	CreatedAt   time.Time `field:"created_at"    json:"created_at"`
-	UpdatedAt   time.Time `field:"updated_at"    json:"updated_at"`
-	DeletedAt   time.Time `field:"deleted_at"    json:"deleted_at"`
+	ID          uuid.UUID  `field:"id"            json:"id"`
+	DateStartAt string     `field:"date_start_at" json:"date_start_at"`
+	DateEndAt   string     `field:"date_end_at"   json:"date_end_at"`
+	TimeSlots   []TimeSlot `field:"time_slots"    json:"time_slots"`
+	Timezone    string     `field:"timezone"      json:"timezone"`
+	StartAt     *time.Time `field:"start_at"      json:"start_at"`
+	CreatedAt   time.Time  `field:"created_at"    json:"created_at"`
+	UpdatedAt   time.Time  `field:"updated_at"    json:"updated_at"`
+	DeletedAt   time.Time  `field:"deleted_at"    json:"deleted_at"`
 }
 
 type SOSDatesList []*SOSDates
+
+// EarliestStartAt 가장 이른 돌봄 시작 시각을 반환한다. 돌봄 날짜가 없으면 nil을 반환한다.
+func (dl SOSDatesList) EarliestStartAt() *time.Time {
+	var earliest *time.Time
+	for _, d := range dl {
+		if d.StartAt != nil && (earliest == nil || d.StartAt.Before(*earliest)) {
+			earliest = d.StartAt
+		}
+	}
+	return earliest
+}
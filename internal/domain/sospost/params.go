@@ -0,0 +1,76 @@
+package sospost
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
+)
+
+const (
+	SortByNewest   = "newest"
+	SortByDeadline = "deadline"
+	SortByNearest  = "nearest"
+	// 검색어와 일치하는 정도가 높은 순
+	SortByRelevance = "relevance"
+	// 조회수, 지원자 수, 저장 수를 합산한 인기 점수가 높은 순
+	SortByPopular = "popular"
+)
+
+const (
+	// 거리로 조회할 수 있는 최대 반경(km)
+	MaxRadiusKm = 100
+	// 검색어의 최대 길이 (글자 수 기준)
+	MaxSearchKeywordLength = 100
+)
+
+// FindSOSPostsParams 돌봄급구 게시글 목록 조회 조건
+type FindSOSPostsParams struct {
+	ViewerID   uuid.NullUUID
+	Page       int
+	Size       int
+	SortBy     string
+	FilterType string
+	// 비어 있으면 모든 상태의 게시글을 조회한다.
+	Status Status
+	// 주어진 행정구역과 그 하위 지역의 게시글만 조회한다.
+	Region *string
+	// 거리 필터와 가까운 순 정렬의 기준 좌표
+	Origin   *commonvo.Location
+	RadiusKm *float64
+	// 비어 있는 값은 해당 조건으로 필터링하지 않는다.
+	CareType    CareType
+	RewardType  RewardType
+	CarerGender CarerGender
+	// 금액 범위(원)가 주어지면 사례 금액이 정해지지 않은 게시글은 제외된다.
+	RewardUnit      RewardUnit
+	MinRewardAmount *int
+	MaxRewardAmount *int
+	// 주어진 돌봄 조건을 모두 포함하는 게시글만 조회한다.
+	ConditionIDs []uuid.UUID
+	// 돌봄 날짜가 주어진 기간과 겹치는 게시글만 조회한다.
+	DateFrom *time.Time
+	DateTo   *time.Time
+	// 돌봄 시간대가 주어진 시간대(HH:MM, 게시글의 현지 시각)와 겹치는 게시글만 조회한다.
+	TimeFrom *string
+	TimeTo   *string
+	Breed    *string
+	// 돌봄 시작일이 지난 게시글도 함께 조회한다.
+	IncludePast bool
+	// 제목 또는 내용에 검색어를 포함하는 게시글만 조회한다.
+	Keyword *string
+	// 주어지면 Page 대신 커서 다음 게시글부터 조회한다.
+	Cursor *Cursor
+}
+
+// HasSearchFilters 작성자별 조회에서 지원하지 않는 검색 조건이 주어졌는지 확인한다.
+// 작성자별 조회는 반려동물 종류와 게시글 상태로만 필터링할 수 있다.
+func (p FindSOSPostsParams) HasSearchFilters() bool {
+	return p.Region != nil || p.Origin != nil || p.RadiusKm != nil ||
+		p.CareType != "" || p.RewardType != "" || p.CarerGender != "" ||
+		p.RewardUnit != "" || p.MinRewardAmount != nil || p.MaxRewardAmount != nil ||
+		len(p.ConditionIDs) > 0 || p.DateFrom != nil || p.DateTo != nil ||
+		p.TimeFrom != nil || p.TimeTo != nil || p.Breed != nil ||
+		p.IncludePast || p.Keyword != nil ||
+		p.SortBy == SortByNearest || p.SortBy == SortByRelevance
+}
@@ -1,6 +1,9 @@
 package sospost
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
+)
 
 type WriteSOSPostRequest struct {
 	Title        string        `json:"title"        validate:"required"`
@@ -11,8 +14,12 @@ type WriteSOSPostRequest struct {
 	CareType     CareType      `json:"careType"     validate:"required,oneof=foster visiting"`
 	CarerGender  CarerGender   `json:"carerGender"  validate:"required,oneof=male female all"`
 	RewardType   RewardType    `json:"rewardType"   validate:"required,oneof=fee gifticon negotiable"`
+	RewardDetail *RewardDetail `json:"rewardDetail"`
 	ConditionIDs []uuid.UUID   `json:"conditionIds" validate:"required"`
 	PetIDs       []uuid.UUID   `json:"petIds"       validate:"required,gte=1"`
+	// 행정구역 이름 (예: 서울특별시 마포구 합정동)
+	Region   *string            `json:"region"   validate:"omitempty,max=100"`
+	Location *commonvo.Location `json:"location"`
 }
 
 type UpdateSOSPostRequest struct {
@@ -25,6 +32,14 @@ type UpdateSOSPostRequest struct {
 	CareType     CareType      `json:"careType"     validate:"required,oneof=foster visiting"`
 	CarerGender  CarerGender   `json:"carerGender"  validate:"required,oneof=male female all"`
 	RewardType   RewardType    `json:"rewardType"   validate:"required,oneof=fee gifticon negotiable"`
+	RewardDetail *RewardDetail `json:"rewardDetail"`
 	ConditionIDs []uuid.UUID   `json:"conditionIds" validate:"required"`
 	PetIDs       []uuid.UUID   `json:"petIds"       validate:"required,gte=1"`
+	// 행정구역 이름 (예: 서울특별시 마포구 합정동)
+	Region   *string            `json:"region"   validate:"omitempty,max=100"`
+	Location *commonvo.Location `json:"location"`
+}
+
+type UpdateSOSPostStatusRequest struct {
+	Status Status `json:"status" validate:"required,oneof=open matched completed cancelled"`
 }
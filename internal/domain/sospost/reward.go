@@ -0,0 +1,61 @@
+package sospost
+
+import (
+	"database/sql"
+
+	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+)
+
+// RewardUnit 사례 금액의 지급 단위
+type RewardUnit string
+
+const (
+	RewardUnitPerVisit RewardUnit = "per_visit"
+	RewardUnitPerDay   RewardUnit = "per_day"
+	RewardUnitTotal    RewardUnit = "total"
+)
+
+// RewardDetail 사례 금액과 지급 단위, 기프티콘 설명. 금액은 원(KRW) 단위다.
+type RewardDetail struct {
+	Amount              *int        `json:"amount"              validate:"omitempty,gte=0,lte=10000000"`
+	Unit                *RewardUnit `json:"unit"                validate:"omitempty,oneof=per_visit per_day total"`
+	GifticonDescription *string     `json:"gifticonDescription" validate:"omitempty,max=100"`
+}
+
+// 사례 정보가 하나도 없으면 nil을 반환한다.
+func NewRewardDetail(amount sql.NullInt32, unit, gifticonDescription sql.NullString) *RewardDetail {
+	if !amount.Valid && !unit.Valid && !gifticonDescription.Valid {
+		return nil
+	}
+
+	detail := &RewardDetail{
+		Amount:              utils.NullInt32ToIntPtr(amount),
+		GifticonDescription: utils.NullStrToStrPtr(gifticonDescription),
+	}
+	if unit.Valid {
+		rewardUnit := RewardUnit(unit.String)
+		detail.Unit = &rewardUnit
+	}
+	return detail
+}
+
+func (r *RewardDetail) NullAmount() sql.NullInt32 {
+	if r == nil {
+		return sql.NullInt32{}
+	}
+	return utils.IntPtrToNullInt32(r.Amount)
+}
+
+func (r *RewardDetail) NullUnit() sql.NullString {
+	if r == nil || r.Unit == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(*r.Unit), Valid: true}
+}
+
+func (r *RewardDetail) NullGifticonDescription() sql.NullString {
+	if r == nil {
+		return sql.NullString{}
+	}
+	return utils.StrPtrToNullStr(r.GifticonDescription)
+}
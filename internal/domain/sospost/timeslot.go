@@ -0,0 +1,101 @@
+package sospost
+
+import (
+	"encoding/json"
+	"log"
+	"slices"
+	"strings"
+	"time"
+)
+
+// 돌봄 날짜에 시간대를 지정하지 않은 경우 사용하는 시간대
+const DefaultTimezone = "Asia/Seoul"
+
+// 돌봄 시간대의 시각 형식 (HH:MM)
+const TimeOfDayLayout = "15:04"
+
+// TimeSlot 돌봄 기간 동안 매일 돌봄이 필요한 시간대. 시각은 돌봄 날짜의 시간대 기준 현지 시각이다.
+type TimeSlot struct {
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
+
+// 두 시간대가 겹치는지 확인한다. 한 시간대가 끝나는 시각에 다른 시간대가 시작하는 경우는 겹치지 않는다.
+func (s TimeSlot) overlaps(other TimeSlot) bool {
+	return s.StartTime < other.EndTime && other.StartTime < s.EndTime
+}
+
+// 두 돌봄 시간대 목록이 같은 날에 겹치는지 확인한다.
+func timeSlotsOverlap(a, b []TimeSlot) bool {
+	for _, slotA := range a {
+		for _, slotB := range b {
+			if slotA.overlaps(slotB) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WithDefaults 시간대가 없으면 기본 시간대를, 돌봄 시간대가 없으면 빈 목록을 채운다.
+func (d SOSDateView) WithDefaults() SOSDateView {
+	if d.Timezone == "" {
+		d.Timezone = DefaultTimezone
+	}
+	if d.TimeSlots == nil {
+		d.TimeSlots = make([]TimeSlot, 0)
+	}
+	return d
+}
+
+// StartAt 돌봄이 처음 시작되는 시각. 돌봄 시간대가 없으면 돌봄 시작일 0시다.
+func (d SOSDateView) StartAt() (time.Time, error) {
+	d = d.WithDefaults()
+	location, err := time.LoadLocation(d.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	date, err := time.Parse(time.DateOnly, d.DateStartAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var hour, minute int
+	if len(d.TimeSlots) > 0 {
+		earliest := slices.MinFunc(d.TimeSlots, func(a, b TimeSlot) int {
+			return strings.Compare(a.StartTime, b.StartTime)
+		})
+		startTime, err := time.Parse(TimeOfDayLayout, earliest.StartTime)
+		if err != nil {
+			return time.Time{}, err
+		}
+		hour, minute = startTime.Hour(), startTime.Minute()
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, location), nil
+}
+
+// TimeSlotsJSON DB에 저장할 돌봄 시간대 목록
+func (d SOSDateView) TimeSlotsJSON() json.RawMessage {
+	timeSlots, err := json.Marshal(d.WithDefaults().TimeSlots)
+	if err != nil {
+		log.Println("Error marshalling timeSlots:", err)
+		return json.RawMessage(JSONEmptyArray)
+	}
+	return timeSlots
+}
+
+// ParseTimeSlots DB에 저장된 돌봄 시간대 목록을 해석한다.
+func ParseTimeSlots(rows json.RawMessage) []TimeSlot {
+	timeSlots := make([]TimeSlot, 0)
+
+	if len(rows) == 0 || string(rows) == JSONNullString || string(rows) == JSONEmptyArray {
+		return timeSlots
+	}
+	if err := json.Unmarshal(rows, &timeSlots); err != nil {
+		log.Println("Error unmarshalling timeSlots:", err)
+		return make([]TimeSlot, 0)
+	}
+
+	return timeSlots
+}
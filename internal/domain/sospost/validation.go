@@ -0,0 +1,106 @@
+package sospost
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+)
+
+// Validate 태그로 검증할 수 없는 사례 정보와 돌봄 날짜를 검증한다.
+func (r *WriteSOSPostRequest) Validate() error {
+	if err := ValidateReward(r.RewardType, r.RewardDetail); err != nil {
+		return err
+	}
+	return ValidateSOSDates(r.Dates)
+}
+
+// Validate 태그로 검증할 수 없는 사례 정보와 돌봄 날짜를 검증한다.
+func (r *UpdateSOSPostRequest) Validate() error {
+	if err := ValidateReward(r.RewardType, r.RewardDetail); err != nil {
+		return err
+	}
+	return ValidateSOSDates(r.Dates)
+}
+
+// ValidateReward 사례 유형에 맞는 사례 정보인지 검증한다.
+// 사례 정보는 선택 항목이다. 주어진 경우 금액과 지급 단위는 함께 있어야 하고, 기프티콘 설명은 기프티콘 사례에만 적을 수 있다.
+func ValidateReward(rewardType RewardType, detail *RewardDetail) error {
+	if detail == nil {
+		return nil
+	}
+
+	if (detail.Amount == nil) != (detail.Unit == nil) {
+		return pnd.ErrInvalidBody(errors.New("reward amount and unit must be given together"))
+	}
+	if rewardType != RewardTypeGifticon && detail.GifticonDescription != nil {
+		return pnd.ErrInvalidBody(errors.New("gifticon description is only allowed for gifticon reward"))
+	}
+
+	return nil
+}
+
+// ValidateSOSDates 돌봄 날짜와 돌봄 시간대를 검증한다.
+// 기간이 겹치는 돌봄 날짜끼리는 같은 날의 돌봄 시간대가 서로 겹칠 수 없다.
+// 돌봄 시간대를 지정하지 않은 돌봄 날짜는 겹침 검사에서 제외한다.
+func ValidateSOSDates(dates []SOSDateView) error {
+	for i, date := range dates {
+		date = date.WithDefaults()
+		if err := validateSOSDate(date); err != nil {
+			return err
+		}
+
+		for _, other := range dates[:i] {
+			if len(date.TimeSlots) == 0 || len(other.TimeSlots) == 0 {
+				continue
+			}
+			// 날짜 형식은 이미 검증했으므로 문자열 비교로 기간이 겹치는지 확인할 수 있다.
+			if date.DateStartAt > other.DateEndAt || other.DateStartAt > date.DateEndAt {
+				continue
+			}
+			if timeSlotsOverlap(date.TimeSlots, other.TimeSlots) {
+				return pnd.ErrInvalidBody(errors.New("time slots must not overlap on the same day"))
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSOSDate(date SOSDateView) error {
+	if _, err := time.Parse(time.DateOnly, date.DateStartAt); err != nil {
+		return pnd.ErrInvalidBody(fmt.Errorf("expected YYYY-MM-DD for date: %s", date.DateStartAt))
+	}
+	if _, err := time.Parse(time.DateOnly, date.DateEndAt); err != nil {
+		return pnd.ErrInvalidBody(fmt.Errorf("expected YYYY-MM-DD for date: %s", date.DateEndAt))
+	}
+	if date.DateStartAt > date.DateEndAt {
+		return pnd.ErrInvalidBody(errors.New("dateStartAt must not be after dateEndAt"))
+	}
+	if _, err := time.LoadLocation(date.Timezone); err != nil {
+		return pnd.ErrInvalidBody(fmt.Errorf("invalid timezone: %s", date.Timezone))
+	}
+
+	for i, slot := range date.TimeSlots {
+		if !isTimeOfDay(slot.StartTime) || !isTimeOfDay(slot.EndTime) {
+			return pnd.ErrInvalidBody(errors.New("expected HH:MM for time slot"))
+		}
+		if slot.StartTime >= slot.EndTime {
+			return pnd.ErrInvalidBody(errors.New("time slot must end after it starts"))
+		}
+		for _, other := range date.TimeSlots[:i] {
+			if slot.overlaps(other) {
+				return pnd.ErrInvalidBody(errors.New("time slots must not overlap on the same day"))
+			}
+		}
+	}
+
+	return nil
+}
+
+// HH:MM 형식의 시각인지 확인한다. 문자열 비교로 시각의 순서를 비교할 수 있도록 두 자리 형식만 허용한다.
+func isTimeOfDay(value string) bool {
+	parsed, err := time.Parse(TimeOfDayLayout, value)
+	return err == nil && parsed.Format(TimeOfDayLayout) == value
+}
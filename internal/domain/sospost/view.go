@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	pnd "github.com/pet-sitter/pets-next-door-api/api"
 	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/pet"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/soscondition"
@@ -12,96 +13,116 @@ import (
 )
 
 type ViewParams struct {
-	ID          uuid.UUID
-	AuthorID    uuid.UUID
-	Title       string
-	Content     string
-	MediaList   media.ListView
-	Conditions  soscondition.ListView
-	Pets        []pet.DetailView
-	Reward      string
-	SOSDates    []SOSDateView
-	CareType    CareType
-	CarerGender CarerGender
-	RewardType  RewardType
-	ThumbnailID uuid.NullUUID
-	CreatedAt   string
-	UpdatedAt   string
+	ID           uuid.UUID
+	AuthorID     uuid.UUID
+	Title        string
+	Content      string
+	MediaList    media.ListView
+	Conditions   soscondition.ListView
+	Pets         []pet.DetailView
+	Reward       string
+	SOSDates     []SOSDateView
+	CareType     CareType
+	CarerGender  CarerGender
+	RewardType   RewardType
+	RewardDetail *RewardDetail
+	ThumbnailID  uuid.NullUUID
+	Status       Status
+	Region       *string
+	Location     *commonvo.Location
+	CreatedAt    string
+	UpdatedAt    string
 }
 
 type ViewParamsInput struct {
-	ID          uuid.UUID
-	AuthorID    uuid.UUID
-	Title       string
-	Content     string
-	MediaList   media.ListView
-	Conditions  soscondition.ListView
-	Pets        []pet.DetailView
-	Reward      string
-	SOSDates    []SOSDateView
-	CareType    string
-	CarerGender string
-	RewardType  string
-	ThumbnailID uuid.NullUUID
-	CreatedAt   string
-	UpdatedAt   string
+	ID           uuid.UUID
+	AuthorID     uuid.UUID
+	Title        string
+	Content      string
+	MediaList    media.ListView
+	Conditions   soscondition.ListView
+	Pets         []pet.DetailView
+	Reward       string
+	SOSDates     []SOSDateView
+	CareType     string
+	CarerGender  string
+	RewardType   string
+	RewardDetail *RewardDetail
+	ThumbnailID  uuid.NullUUID
+	Status       string
+	Region       *string
+	Location     *commonvo.Location
+	CreatedAt    string
+	UpdatedAt    string
 }
 
 type DetailView struct {
-	ID          uuid.UUID             `json:"id"`
-	AuthorID    uuid.UUID             `json:"authorId"`
-	Title       string                `json:"title"`
-	Content     string                `json:"content"`
-	Media       media.ListView        `json:"media"`
-	Conditions  soscondition.ListView `json:"conditions"`
-	Pets        []pet.DetailView      `json:"pets"`
-	Reward      string                `json:"reward"`
-	Dates       []SOSDateView         `json:"dates"`
-	CareType    CareType              `json:"careType"`
-	CarerGender CarerGender           `json:"carerGender"`
-	RewardType  RewardType            `json:"rewardType"`
-	ThumbnailID uuid.NullUUID         `json:"thumbnailId"`
-	CreatedAt   string                `json:"createdAt"`
-	UpdatedAt   string                `json:"updatedAt"`
+	ID           uuid.UUID             `json:"id"`
+	AuthorID     uuid.UUID             `json:"authorId"`
+	Title        string                `json:"title"`
+	Content      string                `json:"content"`
+	Media        media.ListView        `json:"media"`
+	Conditions   soscondition.ListView `json:"conditions"`
+	Pets         []pet.DetailView      `json:"pets"`
+	Reward       string                `json:"reward"`
+	Dates        []SOSDateView         `json:"dates"`
+	CareType     CareType              `json:"careType"`
+	CarerGender  CarerGender           `json:"carerGender"`
+	RewardType   RewardType            `json:"rewardType"`
+	RewardDetail *RewardDetail         `json:"rewardDetail"`
+	ThumbnailID  uuid.NullUUID         `json:"thumbnailId"`
+	Status       Status                `json:"status"`
+	Region       *string               `json:"region"`
+	Location     *commonvo.Location    `json:"location"`
+	CreatedAt    string                `json:"createdAt"`
+	UpdatedAt    string                `json:"updatedAt"`
 }
 
 func ToDetailView(params ViewParams) *DetailView {
 	return &DetailView{
-		ID:          params.ID,
-		AuthorID:    params.AuthorID,
-		Title:       params.Title,
-		Content:     params.Content,
-		Media:       params.MediaList,
-		Conditions:  params.Conditions,
-		Pets:        params.Pets,
-		Reward:      params.Reward,
-		Dates:       params.SOSDates,
-		CareType:    params.CareType,
-		CarerGender: params.CarerGender,
-		RewardType:  params.RewardType,
-		ThumbnailID: params.ThumbnailID,
-		CreatedAt:   params.CreatedAt,
-		UpdatedAt:   params.UpdatedAt,
+		ID:           params.ID,
+		AuthorID:     params.AuthorID,
+		Title:        params.Title,
+		Content:      params.Content,
+		Media:        params.MediaList,
+		Conditions:   params.Conditions,
+		Pets:         params.Pets,
+		Reward:       params.Reward,
+		Dates:        params.SOSDates,
+		CareType:     params.CareType,
+		CarerGender:  params.CarerGender,
+		RewardType:   params.RewardType,
+		RewardDetail: params.RewardDetail,
+		ThumbnailID:  params.ThumbnailID,
+		Status:       params.Status,
+		Region:       params.Region,
+		Location:     params.Location,
+		CreatedAt:    params.CreatedAt,
+		UpdatedAt:    params.UpdatedAt,
 	}
 }
 
 func CreateViewParams(input ViewParamsInput) ViewParams {
 	return ViewParams{
-		ID:          input.ID,
-		AuthorID:    input.AuthorID,
-		Title:       input.Title,
-		Content:     input.Content,
-		MediaList:   input.MediaList,
-		Conditions:  input.Conditions,
-		Pets:        input.Pets,
-		Reward:      input.Reward,
-		SOSDates:    input.SOSDates,
-		CareType:    CareType(input.CareType),
-		CarerGender: CarerGender(input.CarerGender),
-		RewardType:  RewardType(input.RewardType),
-		ThumbnailID: input.ThumbnailID,
-		CreatedAt:   input.CreatedAt,
-		UpdatedAt:   input.UpdatedAt,
+		ID:           input.ID,
+		AuthorID:     input.AuthorID,
+		Title:        input.Title,
+		Content:      input.Content,
+		MediaList:    input.MediaList,
+		Conditions:   input.Conditions,
+		Pets:         input.Pets,
+		Reward:       input.Reward,
+		SOSDates:     input.SOSDates,
+		CareType:     CareType(input.CareType),
+		CarerGender:  CarerGender(input.CarerGender),
+		RewardType:   RewardType(input.RewardType),
+		RewardDetail: input.RewardDetail,
+		ThumbnailID:  input.ThumbnailID,
+		Status:       Status(input.Status),
+		Region:       input.Region,
+		Location:     input.Location,
+		CreatedAt:    input.CreatedAt,
+		UpdatedAt:    input.UpdatedAt,
 	}
 }
 
@@ -113,42 +134,57 @@ func CreateDetailView(
 	sosDates []SOSDateView,
 ) *DetailView {
 	input := ViewParamsInput{
-		ID:          sosPost.ID,
-		AuthorID:    sosPost.AuthorID,
-		Title:       utils.NullStrToStr(sosPost.Title),
-		Content:     utils.NullStrToStr(sosPost.Content),
-		MediaList:   mediaList,
-		Conditions:  conditions,
-		Pets:        pets,
-		Reward:      utils.NullStrToStr(sosPost.Reward),
-		SOSDates:    sosDates,
-		CareType:    sosPost.CareType.String,
-		CarerGender: sosPost.CarerGender.String,
-		RewardType:  sosPost.RewardType.String,
-		ThumbnailID: sosPost.ThumbnailID,
-		CreatedAt:   utils.FormatTimeFromTime(sosPost.CreatedAt),
-		UpdatedAt:   utils.FormatTimeFromTime(sosPost.UpdatedAt),
+		ID:           sosPost.ID,
+		AuthorID:     sosPost.AuthorID,
+		Title:        utils.NullStrToStr(sosPost.Title),
+		Content:      utils.NullStrToStr(sosPost.Content),
+		MediaList:    mediaList,
+		Conditions:   conditions,
+		Pets:         pets,
+		Reward:       utils.NullStrToStr(sosPost.Reward),
+		SOSDates:     sosDates,
+		CareType:     sosPost.CareType.String,
+		CarerGender:  sosPost.CarerGender.String,
+		RewardType:   sosPost.RewardType.String,
+		RewardDetail: NewRewardDetail(sosPost.RewardAmount, sosPost.RewardUnit, sosPost.GifticonDescription),
+		ThumbnailID:  sosPost.ThumbnailID,
+		Status:       sosPost.Status,
+		Region:       utils.NullStrToStrPtr(sosPost.Region),
+		Location:     commonvo.NewLocation(sosPost.Latitude, sosPost.Longitude),
+		CreatedAt:    utils.FormatTimeFromTime(sosPost.CreatedAt),
+		UpdatedAt:    utils.FormatTimeFromTime(sosPost.UpdatedAt),
 	}
 	params := CreateViewParams(input)
 	return ToDetailView(params)
 }
 
+// FindSOSPostView 돌봄급구 게시글 조회 결과.
+// DistanceKm은 기준 좌표로 조회한 경우에만, Highlight는 검색어로 조회한 경우에만 포함된다.
+// IsBookmarked는 로그인한 사용자가 조회한 경우에만 포함된다.
 type FindSOSPostView struct {
-	ID          uuid.UUID                `json:"id"`
-	Author      *user.WithoutPrivateInfo `json:"author"`
-	Title       string                   `json:"title"`
-	Content     string                   `json:"content"`
-	Media       media.ListView           `json:"media"`
-	Conditions  soscondition.ListView    `json:"conditions"`
-	Pets        []pet.DetailView         `json:"pets"`
-	Reward      string                   `json:"reward"`
-	Dates       []SOSDateView            `json:"dates"`
-	CareType    CareType                 `json:"careType"`
-	CarerGender CarerGender              `json:"carerGender"`
-	RewardType  RewardType               `json:"rewardType"`
-	ThumbnailID uuid.NullUUID            `json:"thumbnailId"`
-	CreatedAt   string                   `json:"createdAt"`
-	UpdatedAt   string                   `json:"updatedAt"`
+	ID           uuid.UUID                `json:"id"`
+	Author       *user.WithoutPrivateInfo `json:"author"`
+	Title        string                   `json:"title"`
+	Content      string                   `json:"content"`
+	Media        media.ListView           `json:"media"`
+	Conditions   soscondition.ListView    `json:"conditions"`
+	Pets         []pet.DetailView         `json:"pets"`
+	Reward       string                   `json:"reward"`
+	Dates        []SOSDateView            `json:"dates"`
+	CareType     CareType                 `json:"careType"`
+	CarerGender  CarerGender              `json:"carerGender"`
+	RewardType   RewardType               `json:"rewardType"`
+	RewardDetail *RewardDetail            `json:"rewardDetail"`
+	ThumbnailID  uuid.NullUUID            `json:"thumbnailId"`
+	Status       Status                   `json:"status"`
+	Region       *string                  `json:"region"`
+	Location     *commonvo.Location       `json:"location"`
+	DistanceKm   *float64                 `json:"distanceKm,omitempty"`
+	Highlight    *Highlight               `json:"highlight,omitempty"`
+	IsBookmarked *bool                    `json:"isBookmarked,omitempty"`
+	CreatedAt    string                   `json:"createdAt"`
+	UpdatedAt    string                   `json:"updatedAt"`
+	Counts
 }
 
 func (p *SOSPost) ToFindSOSPostView(
@@ -177,8 +213,11 @@ func (p *SOSPost) ToFindSOSPostView(
 	}
 }
 
+// FindSOSPostListView 돌봄급구 게시글 목록.
+// NextCursor는 커서 기반 페이지네이션을 지원하는 정렬에서 다음 게시글이 있는 경우에만 포함된다.
 type FindSOSPostListView struct {
 	*pnd.PaginatedView[FindSOSPostView]
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 func FromEmptySOSPostList(sosPosts *SOSPostList) *FindSOSPostListView {
@@ -205,21 +244,26 @@ func (p *SOSPostInfo) ToFindSOSPostInfoView(
 	sosDates []SOSDateView,
 ) *FindSOSPostView {
 	return &FindSOSPostView{
-		ID:          p.ID,
-		Author:      author,
-		Title:       p.Title,
-		Content:     p.Content,
-		Media:       mediaList,
-		Conditions:  conditions,
-		Pets:        pets,
-		Reward:      p.Reward,
-		Dates:       sosDates,
-		CareType:    p.CareType,
-		CarerGender: p.CarerGender,
-		RewardType:  p.RewardType,
-		ThumbnailID: p.ThumbnailID,
-		CreatedAt:   utils.FormatDateTimeFromTime(p.CreatedAt),
-		UpdatedAt:   utils.FormatDateTimeFromTime(p.UpdatedAt),
+		ID:           p.ID,
+		Author:       author,
+		Title:        p.Title,
+		Content:      p.Content,
+		Media:        mediaList,
+		Conditions:   conditions,
+		Pets:         pets,
+		Reward:       p.Reward,
+		Dates:        sosDates,
+		CareType:     p.CareType,
+		CarerGender:  p.CarerGender,
+		RewardType:   p.RewardType,
+		RewardDetail: p.RewardDetail,
+		ThumbnailID:  p.ThumbnailID,
+		Status:       p.Status,
+		Region:       p.Region,
+		Location:     p.Location,
+		CreatedAt:    utils.FormatDateTimeFromTime(p.CreatedAt),
+		UpdatedAt:    utils.FormatDateTimeFromTime(p.UpdatedAt),
+		Counts:       p.Counts,
 	}
 }
 
@@ -231,21 +275,25 @@ func UpdateDetailView(
 	sosDates []SOSDateView,
 ) *DetailView {
 	params := ViewParams{
-		ID:          sosPost.ID,
-		AuthorID:    sosPost.AuthorID,
-		Title:       utils.NullStrToStr(sosPost.Title),
-		Content:     utils.NullStrToStr(sosPost.Content),
-		MediaList:   mediaList,
-		Conditions:  conditions,
-		Pets:        pets,
-		Reward:      utils.NullStrToStr(sosPost.Reward),
-		SOSDates:    sosDates,
-		CareType:    CareType(sosPost.CareType.String),
-		CarerGender: CarerGender(sosPost.CarerGender.String),
-		RewardType:  RewardType(sosPost.RewardType.String),
-		ThumbnailID: sosPost.ThumbnailID,
-		CreatedAt:   utils.FormatDateTimeFromTime(sosPost.CreatedAt),
-		UpdatedAt:   utils.FormatDateTimeFromTime(sosPost.UpdatedAt),
+		ID:           sosPost.ID,
+		AuthorID:     sosPost.AuthorID,
+		Title:        utils.NullStrToStr(sosPost.Title),
+		Content:      utils.NullStrToStr(sosPost.Content),
+		MediaList:    mediaList,
+		Conditions:   conditions,
+		Pets:         pets,
+		Reward:       utils.NullStrToStr(sosPost.Reward),
+		SOSDates:     sosDates,
+		CareType:     CareType(sosPost.CareType.String),
+		CarerGender:  CarerGender(sosPost.CarerGender.String),
+		RewardType:   RewardType(sosPost.RewardType.String),
+		RewardDetail: NewRewardDetail(sosPost.RewardAmount, sosPost.RewardUnit, sosPost.GifticonDescription),
+		ThumbnailID:  sosPost.ThumbnailID,
+		Status:       Status(sosPost.Status),
+		Region:       utils.NullStrToStrPtr(sosPost.Region),
+		Location:     commonvo.NewLocation(sosPost.Latitude, sosPost.Longitude),
+		CreatedAt:    utils.FormatDateTimeFromTime(sosPost.CreatedAt),
+		UpdatedAt:    utils.FormatDateTimeFromTime(sosPost.UpdatedAt),
 	}
 	return ToDetailView(params)
 }
@@ -253,13 +301,19 @@ func UpdateDetailView(
 type SOSDateView struct {
 	DateStartAt string `json:"dateStartAt"`
 	DateEndAt   string `json:"dateEndAt"`
+	// 매일 돌봄이 필요한 시간대. 비어 있으면 하루 종일 돌봄이 필요하다.
+	TimeSlots []TimeSlot `json:"timeSlots"`
+	// 돌봄 시간대의 기준이 되는 IANA 시간대 (기본값 Asia/Seoul)
+	Timezone string `json:"timezone"`
 }
 
 func (d *SOSDates) ToSOSDateView() SOSDateView {
 	return SOSDateView{
 		DateStartAt: utils.FormatDateString(d.DateStartAt),
 		DateEndAt:   utils.FormatDateString(d.DateEndAt),
-	}
+		TimeSlots:   d.TimeSlots,
+		Timezone:    d.Timezone,
+	}.WithDefaults()
 }
 
 func ToListViewFromSOSDateRows(rows []databasegen.FindDatesBySOSPostIDRow) []SOSDateView {
@@ -268,6 +322,8 @@ func ToListViewFromSOSDateRows(rows []databasegen.FindDatesBySOSPostIDRow) []SOS
 		date := SOSDates{
 			DateStartAt: utils.NullTimeToStr(row.DateStartAt),
 			DateEndAt:   utils.NullTimeToStr(row.DateEndAt),
+			TimeSlots:   ParseTimeSlots(row.TimeSlots),
+			Timezone:    row.Timezone,
 		}
 		sosDateViews[i] = date.ToSOSDateView()
 	}
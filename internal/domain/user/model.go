@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
 )
 
@@ -39,6 +40,8 @@ type WithProfileImage struct {
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	DeletedAt            sql.NullTime
+	Region               *string
+	Location             *commonvo.Location
 }
 
 func ToWithProfileImage(row databasegen.FindUserRow) *WithProfileImage {
@@ -52,6 +55,8 @@ func ToWithProfileImage(row databasegen.FindUserRow) *WithProfileImage {
 		FirebaseUID:          row.FbUid.String,
 		CreatedAt:            row.CreatedAt,
 		UpdatedAt:            row.UpdatedAt,
+		Region:               utils.NullStrToStrPtr(row.Region),
+		Location:             commonvo.NewLocation(row.Latitude, row.Longitude),
 	}
 }
 
@@ -64,6 +69,8 @@ func (u *WithProfileImage) ToInternalView() *InternalView {
 		ProfileImageURL:      u.ProfileImageURL,
 		FirebaseProviderType: u.FirebaseProviderType,
 		FirebaseUID:          u.FirebaseUID,
+		Region:               u.Region,
+		Location:             u.Location,
 	}
 }
 
@@ -75,6 +82,8 @@ func (u *WithProfileImage) ToMyProfileView() *MyProfileView {
 		Fullname:             u.Fullname,
 		ProfileImageURL:      u.ProfileImageURL,
 		FirebaseProviderType: u.FirebaseProviderType,
+		Region:               u.Region,
+		Location:             u.Location,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
 	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
 )
 
@@ -41,3 +42,9 @@ type UpdateUserRequest struct {
 	Nickname       string        `json:"nickname"       validate:"required"`
 	ProfileImageID uuid.NullUUID `json:"profileImageId" validate:"omitempty"`
 }
+
+// UpdateLocationRequest 내 위치 수정 요청. 전달하지 않은 값은 지워진다.
+type UpdateLocationRequest struct {
+	Region   *string            `json:"region"   validate:"omitempty,max=100"`
+	Location *commonvo.Location `json:"location"`
+}
@@ -1,9 +1,12 @@
 package user
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	pnd "github.com/pet-sitter/pets-next-door-api/api"
 	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/pet"
 	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
 )
@@ -16,6 +19,8 @@ type InternalView struct {
 	ProfileImageURL      *string              `json:"profileImageUrl"`
 	FirebaseProviderType FirebaseProviderType `json:"fbProviderType"`
 	FirebaseUID          string               `json:"fbUid"`
+	Region               *string              `json:"region"`
+	Location             *commonvo.Location   `json:"location"`
 }
 
 func (r *InternalView) ToMyProfileView() *MyProfileView {
@@ -26,6 +31,8 @@ func (r *InternalView) ToMyProfileView() *MyProfileView {
 		Fullname:             r.Fullname,
 		ProfileImageURL:      r.ProfileImageURL,
 		FirebaseProviderType: r.FirebaseProviderType,
+		Region:               r.Region,
+		Location:             r.Location,
 	}
 }
 
@@ -36,6 +43,8 @@ type MyProfileView struct {
 	Fullname             string               `json:"fullname"`
 	ProfileImageURL      *string              `json:"profileImageUrl"`
 	FirebaseProviderType FirebaseProviderType `json:"fbProviderType"`
+	Region               *string              `json:"region"`
+	Location             *commonvo.Location   `json:"location"`
 }
 
 type ProfileView struct {
@@ -94,6 +103,19 @@ func ToWithoutPrivateInfo(row databasegen.FindUserRow) *WithoutPrivateInfo {
 	}
 }
 
+// ToWithoutPrivateInfoMap 사용자 ID로 사용자 정보를 찾을 수 있도록 맵으로 변환한다.
+func ToWithoutPrivateInfoMap(rows []databasegen.FindUsersByIDsRow) map[uuid.UUID]*WithoutPrivateInfo {
+	users := make(map[uuid.UUID]*WithoutPrivateInfo, len(rows))
+	for _, row := range rows {
+		users[row.ID] = &WithoutPrivateInfo{
+			ID:              row.ID,
+			Nickname:        row.Nickname,
+			ProfileImageURL: utils.NullStrToStrPtr(row.ProfileImageUrl),
+		}
+	}
+	return users
+}
+
 type ListWithoutPrivateInfo struct {
 	*pnd.PaginatedView[WithoutPrivateInfo]
 }
@@ -117,3 +139,27 @@ func ToListWithoutPrivateInfo(
 	ul.CalcLastPage()
 	return ul
 }
+
+type BlockedUserView struct {
+	ID              uuid.UUID `json:"id"`
+	Nickname        string    `json:"nickname"`
+	ProfileImageURL *string   `json:"profileImageUrl"`
+	BlockedAt       time.Time `json:"blockedAt"`
+}
+
+type BlockedUserListView struct {
+	Items []BlockedUserView `json:"items"`
+}
+
+func ToBlockedUserListView(rows []databasegen.FindBlockedUsersRow) *BlockedUserListView {
+	items := make([]BlockedUserView, len(rows))
+	for i, row := range rows {
+		items[i] = BlockedUserView{
+			ID:              row.ID,
+			Nickname:        row.Nickname,
+			ProfileImageURL: utils.NullStrToStrPtr(row.ProfileImageUrl),
+			BlockedAt:       row.BlockedAt,
+		}
+	}
+	return &BlockedUserListView{Items: items}
+}
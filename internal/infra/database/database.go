@@ -53,6 +53,9 @@ func (db *DB) Flush() error {
 		"sos_posts_dates",
 		"sos_dates",
 		"sos_posts",
+		"chat_messages",
+		"user_chat_rooms",
+		"chat_rooms",
 	}
 
 	for _, tableName := range tableNames {
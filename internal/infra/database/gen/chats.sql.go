@@ -13,6 +13,57 @@ import (
 	"github.com/google/uuid"
 )
 
+const createDirectRoom = `-- name: CreateDirectRoom :one
+INSERT INTO chat_rooms
+(id,
+ name,
+ room_type,
+ sos_post_id,
+ direct_key,
+ created_at,
+ updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+ON CONFLICT (direct_key) WHERE deleted_at IS NULL DO NOTHING
+RETURNING id, name, room_type, sos_post_id, created_at, updated_at
+`
+
+type CreateDirectRoomParams struct {
+	ID        uuid.UUID
+	Name      string
+	RoomType  string
+	SosPostID uuid.NullUUID
+	DirectKey sql.NullString
+}
+
+type CreateDirectRoomRow struct {
+	ID        uuid.UUID
+	Name      string
+	RoomType  string
+	SosPostID uuid.NullUUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) CreateDirectRoom(ctx context.Context, arg CreateDirectRoomParams) (CreateDirectRoomRow, error) {
+	row := q.db.QueryRowContext(ctx, createDirectRoom,
+		arg.ID,
+		arg.Name,
+		arg.RoomType,
+		arg.SosPostID,
+		arg.DirectKey,
+	)
+	var i CreateDirectRoomRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.RoomType,
+		&i.SosPostID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const createRoom = `-- name: CreateRoom :one
 INSERT INTO chat_rooms
 (id,
@@ -51,6 +102,18 @@ func (q *Queries) CreateRoom(ctx context.Context, arg CreateRoomParams) (CreateR
 	return i, err
 }
 
+const deleteMessage = `-- name: DeleteMessage :exec
+UPDATE
+    chat_messages
+SET deleted_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) DeleteMessage(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteMessage, id)
+	return err
+}
+
 const deleteRoom = `-- name: DeleteRoom :exec
 UPDATE
     chat_rooms
@@ -82,7 +145,8 @@ const existsUserInRoom = `-- name: ExistsUserInRoom :one
 SELECT EXISTS (SELECT 1
                FROM user_chat_rooms
                WHERE room_id = $1
-                 AND user_id = $2)
+                 AND user_id = $2
+                 AND left_at IS NULL)
 `
 
 type ExistsUserInRoomParams struct {
@@ -114,7 +178,30 @@ SELECT user_chat_rooms.id,
        chat_rooms.name       AS chat_room_name,
        chat_rooms.room_type  AS chat_room_type,
        chat_rooms.created_at AS chat_room_created_at,
-       chat_rooms.updated_at AS chat_room_updated_at
+       chat_rooms.updated_at AS chat_room_updated_at,
+       user_chat_rooms.last_read_message_id,
+       chat_rooms.sos_post_id AS chat_room_sos_post_id,
+       chat_room_image.url    AS chat_room_image_url,
+       (SELECT COUNT(*)
+        FROM chat_messages unread
+        WHERE unread.room_id = chat_rooms.id
+          AND unread.deleted_at IS NULL
+          AND unread.user_id <> user_chat_rooms.user_id
+          AND (user_chat_rooms.last_read_message_id IS NULL
+            OR unread.id > user_chat_rooms.last_read_message_id)
+          -- 차단 관계인 사용자의 메시지는 메시지 목록과 마찬가지로 제외한다.
+          AND NOT EXISTS (SELECT 1
+                          FROM user_blocks
+                          WHERE (user_blocks.blocker_id = user_chat_rooms.user_id
+                                 AND user_blocks.blocked_id = unread.user_id)
+                             OR (user_blocks.blocker_id = unread.user_id
+                                 AND user_blocks.blocked_id = user_chat_rooms.user_id))
+       )                     AS unread_count,
+       last_message.id           AS last_message_id,
+       last_message.user_id      AS last_message_user_id,
+       last_message.message_type AS last_message_type,
+       last_message.content      AS last_message_content,
+       last_message.created_at   AS last_message_created_at
 FROM user_chat_rooms
          JOIN users
               ON users.id = user_chat_rooms.user_id
@@ -122,29 +209,57 @@ FROM user_chat_rooms
               ON chat_rooms.id = user_chat_rooms.room_id
          LEFT OUTER JOIN media
                          ON users.profile_image_id = media.id
+         LEFT OUTER JOIN media chat_room_image
+                         ON chat_rooms.image_id = chat_room_image.id
+         LEFT JOIN LATERAL (SELECT id,
+                                   user_id,
+                                   message_type,
+                                   content,
+                                   created_at
+                            FROM chat_messages
+                            WHERE chat_messages.room_id = chat_rooms.id
+                              AND chat_messages.deleted_at IS NULL
+                              AND NOT EXISTS (SELECT 1
+                                              FROM user_blocks
+                                              WHERE (user_blocks.blocker_id = user_chat_rooms.user_id
+                                                     AND user_blocks.blocked_id = chat_messages.user_id)
+                                                 OR (user_blocks.blocker_id = chat_messages.user_id
+                                                     AND user_blocks.blocked_id = user_chat_rooms.user_id))
+                            ORDER BY chat_messages.created_at DESC
+                            LIMIT 1) last_message ON TRUE
 WHERE user_chat_rooms.left_at IS NULL
   AND chat_rooms.deleted_at IS NULL
   AND user_chat_rooms.user_id = $1
+ORDER BY COALESCE(last_message.created_at, chat_rooms.created_at) DESC
 `
 
 type FindAllUserChatRoomsByUserUIDRow struct {
-	ID                uuid.UUID
-	UserID            uuid.UUID
-	RoomID            uuid.UUID
-	JoinedAt          time.Time
-	Email             string
-	Nickname          string
-	Fullname          string
-	ProfileImageUrl   sql.NullString
-	FbProviderType    sql.NullString
-	FbUid             sql.NullString
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
-	ChatRoomID        uuid.UUID
-	ChatRoomName      string
-	ChatRoomType      string
-	ChatRoomCreatedAt time.Time
-	ChatRoomUpdatedAt time.Time
+	ID                   uuid.UUID
+	UserID               uuid.UUID
+	RoomID               uuid.UUID
+	JoinedAt             time.Time
+	Email                string
+	Nickname             string
+	Fullname             string
+	ProfileImageUrl      sql.NullString
+	FbProviderType       sql.NullString
+	FbUid                sql.NullString
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	ChatRoomID           uuid.UUID
+	ChatRoomName         string
+	ChatRoomType         string
+	ChatRoomCreatedAt    time.Time
+	ChatRoomUpdatedAt    time.Time
+	LastReadMessageID    uuid.NullUUID
+	ChatRoomSosPostID    uuid.NullUUID
+	ChatRoomImageUrl     sql.NullString
+	UnreadCount          int64
+	LastMessageID        uuid.NullUUID
+	LastMessageUserID    uuid.NullUUID
+	LastMessageType      sql.NullString
+	LastMessageContent   sql.NullString
+	LastMessageCreatedAt sql.NullTime
 }
 
 func (q *Queries) FindAllUserChatRoomsByUserUID(ctx context.Context, userID uuid.UUID) ([]FindAllUserChatRoomsByUserUIDRow, error) {
@@ -174,6 +289,15 @@ func (q *Queries) FindAllUserChatRoomsByUserUID(ctx context.Context, userID uuid
 			&i.ChatRoomType,
 			&i.ChatRoomCreatedAt,
 			&i.ChatRoomUpdatedAt,
+			&i.LastReadMessageID,
+			&i.ChatRoomSosPostID,
+			&i.ChatRoomImageUrl,
+			&i.UnreadCount,
+			&i.LastMessageID,
+			&i.LastMessageUserID,
+			&i.LastMessageType,
+			&i.LastMessageContent,
+			&i.LastMessageCreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -193,22 +317,33 @@ SELECT id,
        user_id,
        room_id,
        message_type,
-       content,
-       created_at
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE room_id = $2
   AND id > $3::uuid
   AND id < $4::uuid
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = $5
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = $5))
 ORDER BY chat_messages.created_at ASC
 LIMIT $1
 `
 
 type FindBetweenMessagesByRoomIDParams struct {
-	Limit  int32
-	RoomID uuid.UUID
-	Prev   uuid.NullUUID
-	Next   uuid.NullUUID
+	Limit    int32
+	RoomID   uuid.UUID
+	Prev     uuid.NullUUID
+	Next     uuid.NullUUID
+	ViewerID uuid.UUID
 }
 
 type FindBetweenMessagesByRoomIDRow struct {
@@ -217,7 +352,10 @@ type FindBetweenMessagesByRoomIDRow struct {
 	RoomID      uuid.UUID
 	MessageType string
 	Content     string
+	ReplyToID   uuid.NullUUID
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
 func (q *Queries) FindBetweenMessagesByRoomID(ctx context.Context, arg FindBetweenMessagesByRoomIDParams) ([]FindBetweenMessagesByRoomIDRow, error) {
@@ -226,6 +364,7 @@ func (q *Queries) FindBetweenMessagesByRoomID(ctx context.Context, arg FindBetwe
 		arg.RoomID,
 		arg.Prev,
 		arg.Next,
+		arg.ViewerID,
 	)
 	if err != nil {
 		return nil, err
@@ -240,7 +379,10 @@ func (q *Queries) FindBetweenMessagesByRoomID(ctx context.Context, arg FindBetwe
 			&i.RoomID,
 			&i.MessageType,
 			&i.Content,
+			&i.ReplyToID,
 			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -255,23 +397,106 @@ func (q *Queries) FindBetweenMessagesByRoomID(ctx context.Context, arg FindBetwe
 	return items, nil
 }
 
-const findMessagesByRoomIDAndSize = `-- name: FindMessagesByRoomIDAndSize :many
+const findMessageByClientMessageID = `-- name: FindMessageByClientMessageID :one
+SELECT id,
+       user_id,
+       room_id,
+       message_type,
+       content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at,
+       client_message_id
+FROM chat_messages
+WHERE user_id = $1
+  AND client_message_id = $2
+`
+
+type FindMessageByClientMessageIDParams struct {
+	UserID          uuid.UUID
+	ClientMessageID sql.NullString
+}
+
+func (q *Queries) FindMessageByClientMessageID(ctx context.Context, arg FindMessageByClientMessageIDParams) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, findMessageByClientMessageID, arg.UserID, arg.ClientMessageID)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RoomID,
+		&i.MessageType,
+		&i.Content,
+		&i.ReplyToID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ClientMessageID,
+	)
+	return i, err
+}
+
+const findMessageByID = `-- name: FindMessageByID :one
 SELECT id,
        user_id,
        room_id,
        message_type,
        content,
-       created_at
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at,
+       client_message_id
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE id = $1
+`
+
+func (q *Queries) FindMessageByID(ctx context.Context, id uuid.UUID) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, findMessageByID, id)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RoomID,
+		&i.MessageType,
+		&i.Content,
+		&i.ReplyToID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ClientMessageID,
+	)
+	return i, err
+}
+
+const findMessagesByRoomIDAndSize = `-- name: FindMessagesByRoomIDAndSize :many
+SELECT id,
+       user_id,
+       room_id,
+       message_type,
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
+FROM chat_messages
+WHERE room_id = $2
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = $3
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = $3))
 ORDER BY chat_messages.created_at DESC
 LIMIT $1
 `
 
 type FindMessagesByRoomIDAndSizeParams struct {
-	Limit  int32
-	RoomID uuid.UUID
+	Limit    int32
+	RoomID   uuid.UUID
+	ViewerID uuid.UUID
 }
 
 type FindMessagesByRoomIDAndSizeRow struct {
@@ -280,11 +505,14 @@ type FindMessagesByRoomIDAndSizeRow struct {
 	RoomID      uuid.UUID
 	MessageType string
 	Content     string
+	ReplyToID   uuid.NullUUID
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
 func (q *Queries) FindMessagesByRoomIDAndSize(ctx context.Context, arg FindMessagesByRoomIDAndSizeParams) ([]FindMessagesByRoomIDAndSizeRow, error) {
-	rows, err := q.db.QueryContext(ctx, findMessagesByRoomIDAndSize, arg.Limit, arg.RoomID)
+	rows, err := q.db.QueryContext(ctx, findMessagesByRoomIDAndSize, arg.Limit, arg.RoomID, arg.ViewerID)
 	if err != nil {
 		return nil, err
 	}
@@ -298,7 +526,10 @@ func (q *Queries) FindMessagesByRoomIDAndSize(ctx context.Context, arg FindMessa
 			&i.RoomID,
 			&i.MessageType,
 			&i.Content,
+			&i.ReplyToID,
 			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -318,22 +549,33 @@ SELECT id,
        user_id,
        room_id,
        message_type,
-       content,
-       created_at
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE room_id = $2
   AND (
     id > $3::uuid
     )
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = $4
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = $4))
 ORDER BY chat_messages.created_at ASC
 LIMIT $1
 `
 
 type FindNextMessageByRoomIDParams struct {
-	Limit  int32
-	RoomID uuid.UUID
-	Next   uuid.NullUUID
+	Limit    int32
+	RoomID   uuid.UUID
+	Next     uuid.NullUUID
+	ViewerID uuid.UUID
 }
 
 type FindNextMessageByRoomIDRow struct {
@@ -342,11 +584,14 @@ type FindNextMessageByRoomIDRow struct {
 	RoomID      uuid.UUID
 	MessageType string
 	Content     string
+	ReplyToID   uuid.NullUUID
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
 func (q *Queries) FindNextMessageByRoomID(ctx context.Context, arg FindNextMessageByRoomIDParams) ([]FindNextMessageByRoomIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, findNextMessageByRoomID, arg.Limit, arg.RoomID, arg.Next)
+	rows, err := q.db.QueryContext(ctx, findNextMessageByRoomID, arg.Limit, arg.RoomID, arg.Next, arg.ViewerID)
 	if err != nil {
 		return nil, err
 	}
@@ -360,7 +605,10 @@ func (q *Queries) FindNextMessageByRoomID(ctx context.Context, arg FindNextMessa
 			&i.RoomID,
 			&i.MessageType,
 			&i.Content,
+			&i.ReplyToID,
 			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -380,22 +628,33 @@ SELECT id,
        user_id,
        room_id,
        message_type,
-       content,
-       created_at
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE room_id = $2
   AND (
     id < $3::uuid
     )
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = $4
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = $4))
 ORDER BY chat_messages.created_at DESC
 LIMIT $1
 `
 
 type FindPrevMessageByRoomIDParams struct {
-	Limit  int32
-	RoomID uuid.UUID
-	Prev   uuid.NullUUID
+	Limit    int32
+	RoomID   uuid.UUID
+	Prev     uuid.NullUUID
+	ViewerID uuid.UUID
 }
 
 type FindPrevMessageByRoomIDRow struct {
@@ -404,11 +663,14 @@ type FindPrevMessageByRoomIDRow struct {
 	RoomID      uuid.UUID
 	MessageType string
 	Content     string
+	ReplyToID   uuid.NullUUID
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   sql.NullTime
 }
 
 func (q *Queries) FindPrevMessageByRoomID(ctx context.Context, arg FindPrevMessageByRoomIDParams) ([]FindPrevMessageByRoomIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, findPrevMessageByRoomID, arg.Limit, arg.RoomID, arg.Prev)
+	rows, err := q.db.QueryContext(ctx, findPrevMessageByRoomID, arg.Limit, arg.RoomID, arg.Prev, arg.ViewerID)
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +684,10 @@ func (q *Queries) FindPrevMessageByRoomID(ctx context.Context, arg FindPrevMessa
 			&i.RoomID,
 			&i.MessageType,
 			&i.Content,
+			&i.ReplyToID,
 			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -437,13 +702,53 @@ func (q *Queries) FindPrevMessageByRoomID(ctx context.Context, arg FindPrevMessa
 	return items, nil
 }
 
-const findRoomByIDAndUserID = `-- name: FindRoomByIDAndUserID :one
+const findRoomByDirectKey = `-- name: FindRoomByDirectKey :one
 SELECT id,
        name,
        room_type,
+       sos_post_id,
        created_at,
        updated_at
 FROM chat_rooms
+WHERE direct_key = $1
+  AND deleted_at IS NULL
+`
+
+type FindRoomByDirectKeyRow struct {
+	ID        uuid.UUID
+	Name      string
+	RoomType  string
+	SosPostID uuid.NullUUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) FindRoomByDirectKey(ctx context.Context, directKey sql.NullString) (FindRoomByDirectKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, findRoomByDirectKey, directKey)
+	var i FindRoomByDirectKeyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.RoomType,
+		&i.SosPostID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findRoomByIDAndUserID = `-- name: FindRoomByIDAndUserID :one
+SELECT chat_rooms.id,
+       chat_rooms.name,
+       chat_rooms.room_type,
+       chat_rooms.sos_post_id,
+       chat_rooms.image_id,
+       media.url AS image_url,
+       chat_rooms.created_at,
+       chat_rooms.updated_at
+FROM chat_rooms
+         LEFT OUTER JOIN media
+                         ON chat_rooms.image_id = media.id
 WHERE chat_rooms.deleted_at IS NULL
   AND (chat_rooms.id = $1)
   AND EXISTS (SELECT 1
@@ -462,6 +767,9 @@ type FindRoomByIDAndUserIDRow struct {
 	ID        uuid.UUID
 	Name      string
 	RoomType  string
+	SosPostID uuid.NullUUID
+	ImageID   uuid.NullUUID
+	ImageUrl  sql.NullString
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -473,30 +781,191 @@ func (q *Queries) FindRoomByIDAndUserID(ctx context.Context, arg FindRoomByIDAnd
 		&i.ID,
 		&i.Name,
 		&i.RoomType,
+		&i.SosPostID,
+		&i.ImageID,
+		&i.ImageUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const findRoomMateIDsByUserID = `-- name: FindRoomMateIDsByUserID :many
+SELECT DISTINCT others.user_id
+FROM user_chat_rooms mine
+         JOIN user_chat_rooms others
+              ON others.room_id = mine.room_id
+WHERE mine.user_id = $1
+  AND mine.left_at IS NULL
+  AND others.left_at IS NULL
+  AND others.user_id <> mine.user_id
+`
+
+func (q *Queries) FindRoomMateIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, findRoomMateIDsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findRoomMemberRole = `-- name: FindRoomMemberRole :one
+SELECT role
+FROM user_chat_rooms
+WHERE room_id = $1
+  AND user_id = $2
+  AND left_at IS NULL
+`
+
+type FindRoomMemberRoleParams struct {
+	RoomID uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) FindRoomMemberRole(ctx context.Context, arg FindRoomMemberRoleParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, findRoomMemberRole, arg.RoomID, arg.UserID)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
+const findRoomMembers = `-- name: FindRoomMembers :many
+SELECT users.id,
+       users.nickname,
+       media.url AS profile_image_url,
+       user_chat_rooms.role,
+       user_chat_rooms.joined_at
+FROM user_chat_rooms
+         JOIN users
+              ON users.id = user_chat_rooms.user_id
+         LEFT OUTER JOIN media
+                         ON users.profile_image_id = media.id
+WHERE user_chat_rooms.room_id = $1
+  AND user_chat_rooms.left_at IS NULL
+ORDER BY user_chat_rooms.joined_at, user_chat_rooms.id
+`
+
+type FindRoomMembersRow struct {
+	ID              uuid.UUID
+	Nickname        string
+	ProfileImageUrl sql.NullString
+	Role            string
+	JoinedAt        time.Time
+}
+
+func (q *Queries) FindRoomMembers(ctx context.Context, roomID uuid.UUID) ([]FindRoomMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, findRoomMembers, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindRoomMembersRow
+	for rows.Next() {
+		var i FindRoomMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Nickname,
+			&i.ProfileImageUrl,
+			&i.Role,
+			&i.JoinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findRoomTypeByID = `-- name: FindRoomTypeByID :one
+SELECT room_type
+FROM chat_rooms
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) FindRoomTypeByID(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, findRoomTypeByID, id)
+	var room_type string
+	err := row.Scan(&room_type)
+	return room_type, err
+}
+
+const findUserIDsByRoomID = `-- name: FindUserIDsByRoomID :many
+SELECT user_id
+FROM user_chat_rooms
+WHERE room_id = $1
+  AND left_at IS NULL
+`
+
+func (q *Queries) FindUserIDsByRoomID(ctx context.Context, roomID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, findUserIDsByRoomID, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const hasNextMessages = `-- name: HasNextMessages :one
 SELECT EXISTS (
     SELECT 1
     FROM chat_messages
-    WHERE chat_messages.deleted_at IS NULL
-      AND room_id = $2
+    WHERE room_id = $2
       AND id > $1  -- 주어진 next UUID보다 이후 메시지
+      -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+      AND NOT EXISTS (SELECT 1
+                      FROM user_blocks
+                      WHERE (user_blocks.blocker_id = $3
+                             AND user_blocks.blocked_id = chat_messages.user_id)
+                         OR (user_blocks.blocker_id = chat_messages.user_id
+                             AND user_blocks.blocked_id = $3))
     LIMIT 1
 )
 `
 
 type HasNextMessagesParams struct {
-	ID     uuid.UUID
-	RoomID uuid.UUID
+	ID       uuid.UUID
+	RoomID   uuid.UUID
+	ViewerID uuid.UUID
 }
 
 func (q *Queries) HasNextMessages(ctx context.Context, arg HasNextMessagesParams) (bool, error) {
-	row := q.db.QueryRowContext(ctx, hasNextMessages, arg.ID, arg.RoomID)
+	row := q.db.QueryRowContext(ctx, hasNextMessages, arg.ID, arg.RoomID, arg.ViewerID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -506,20 +975,27 @@ const hasPrevMessages = `-- name: HasPrevMessages :one
 SELECT EXISTS (
     SELECT 1
     FROM chat_messages
-    WHERE chat_messages.deleted_at IS NULL
-      AND room_id = $2
+    WHERE room_id = $2
       AND id < $1  -- 주어진 prev UUID보다 이전 메시지
+      -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+      AND NOT EXISTS (SELECT 1
+                      FROM user_blocks
+                      WHERE (user_blocks.blocker_id = $3
+                             AND user_blocks.blocked_id = chat_messages.user_id)
+                         OR (user_blocks.blocker_id = chat_messages.user_id
+                             AND user_blocks.blocked_id = $3))
     LIMIT 1
 )
 `
 
 type HasPrevMessagesParams struct {
-	ID     uuid.UUID
-	RoomID uuid.UUID
+	ID       uuid.UUID
+	RoomID   uuid.UUID
+	ViewerID uuid.UUID
 }
 
 func (q *Queries) HasPrevMessages(ctx context.Context, arg HasPrevMessagesParams) (bool, error) {
-	row := q.db.QueryRowContext(ctx, hasPrevMessages, arg.ID, arg.RoomID)
+	row := q.db.QueryRowContext(ctx, hasPrevMessages, arg.ID, arg.RoomID, arg.ViewerID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -530,31 +1006,40 @@ INSERT INTO user_chat_rooms
 (id,
  user_id,
  room_id,
+ role,
  joined_at)
-VALUES ($1, $2, $3, NOW())
-RETURNING id, user_id, room_id, joined_at
+VALUES ($1, $2, $3, $4, NOW())
+RETURNING id, user_id, room_id, role, joined_at
 `
 
 type JoinRoomParams struct {
 	ID     uuid.UUID
 	UserID uuid.UUID
 	RoomID uuid.UUID
+	Role   string
 }
 
 type JoinRoomRow struct {
 	ID       uuid.UUID
 	UserID   uuid.UUID
 	RoomID   uuid.UUID
+	Role     string
 	JoinedAt time.Time
 }
 
 func (q *Queries) JoinRoom(ctx context.Context, arg JoinRoomParams) (JoinRoomRow, error) {
-	row := q.db.QueryRowContext(ctx, joinRoom, arg.ID, arg.UserID, arg.RoomID)
+	row := q.db.QueryRowContext(ctx, joinRoom,
+		arg.ID,
+		arg.UserID,
+		arg.RoomID,
+		arg.Role,
+	)
 	var i JoinRoomRow
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
 		&i.RoomID,
+		&i.Role,
 		&i.JoinedAt,
 	)
 	return i, err
@@ -579,6 +1064,19 @@ func (q *Queries) JoinRooms(ctx context.Context, arg JoinRoomsParams) error {
 	return err
 }
 
+const leaveAllRoomMembers = `-- name: LeaveAllRoomMembers :exec
+UPDATE
+    user_chat_rooms
+SET left_at = NOW()
+WHERE room_id = $1
+  AND left_at IS NULL
+`
+
+func (q *Queries) LeaveAllRoomMembers(ctx context.Context, roomID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, leaveAllRoomMembers, roomID)
+	return err
+}
+
 const leaveRoom = `-- name: LeaveRoom :exec
 UPDATE
     user_chat_rooms
@@ -597,6 +1095,254 @@ func (q *Queries) LeaveRoom(ctx context.Context, arg LeaveRoomParams) error {
 	return err
 }
 
+const promoteNextRoomOwner = `-- name: PromoteNextRoomOwner :exec
+UPDATE
+    user_chat_rooms
+SET role = 'owner'
+WHERE id = (SELECT candidates.id
+            FROM user_chat_rooms candidates
+            WHERE candidates.room_id = $1
+              AND candidates.left_at IS NULL
+            -- 관리자, 먼저 참여한 사용자 순으로 다음 방장을 지정한다.
+            ORDER BY candidates.role = 'admin' DESC, candidates.joined_at, candidates.id
+            LIMIT 1)
+`
+
+func (q *Queries) PromoteNextRoomOwner(ctx context.Context, roomID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, promoteNextRoomOwner, roomID)
+	return err
+}
+
+const searchMessagesByRoomID = `-- name: SearchMessagesByRoomID :many
+SELECT id,
+       user_id,
+       room_id,
+       message_type,
+       content,
+       reply_to_id,
+       created_at,
+       updated_at
+FROM chat_messages
+WHERE room_id = $1
+  AND deleted_at IS NULL
+  AND content ILIKE '%' || $2::text || '%'
+  AND ($3::uuid IS NULL OR id < $3::uuid)
+  AND ($4::uuid IS NULL OR id > $4::uuid)
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = $5
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = $5))
+-- next만 주어진 경우에는 next와 가까운 메시지부터 조회하도록 오래된 순으로 정렬한다.
+ORDER BY CASE WHEN $3::uuid IS NULL AND $4::uuid IS NOT NULL THEN id END,
+         id DESC
+LIMIT $6
+`
+
+type SearchMessagesByRoomIDParams struct {
+	RoomID   uuid.UUID
+	Keyword  string
+	Prev     uuid.NullUUID
+	Next     uuid.NullUUID
+	ViewerID uuid.UUID
+	Limit    int32
+}
+
+type SearchMessagesByRoomIDRow struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	RoomID      uuid.UUID
+	MessageType string
+	Content     string
+	ReplyToID   uuid.NullUUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (q *Queries) SearchMessagesByRoomID(ctx context.Context, arg SearchMessagesByRoomIDParams) ([]SearchMessagesByRoomIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchMessagesByRoomID,
+		arg.RoomID,
+		arg.Keyword,
+		arg.Prev,
+		arg.Next,
+		arg.ViewerID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchMessagesByRoomIDRow
+	for rows.Next() {
+		var i SearchMessagesByRoomIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RoomID,
+			&i.MessageType,
+			&i.Content,
+			&i.ReplyToID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateLastReadMessage = `-- name: UpdateLastReadMessage :one
+UPDATE
+    user_chat_rooms
+SET last_read_message_id = GREATEST(last_read_message_id, $1::uuid)
+WHERE user_chat_rooms.room_id = $2
+  AND user_chat_rooms.user_id = $3
+  AND user_chat_rooms.left_at IS NULL
+  AND EXISTS (SELECT 1
+              FROM chat_messages
+              WHERE chat_messages.id = $1::uuid
+                AND chat_messages.room_id = $2
+                AND chat_messages.deleted_at IS NULL)
+RETURNING user_id, room_id, last_read_message_id
+`
+
+type UpdateLastReadMessageParams struct {
+	MessageID uuid.UUID
+	RoomID    uuid.UUID
+	UserID    uuid.UUID
+}
+
+type UpdateLastReadMessageRow struct {
+	UserID            uuid.UUID
+	RoomID            uuid.UUID
+	LastReadMessageID uuid.NullUUID
+}
+
+func (q *Queries) UpdateLastReadMessage(ctx context.Context, arg UpdateLastReadMessageParams) (UpdateLastReadMessageRow, error) {
+	row := q.db.QueryRowContext(ctx, updateLastReadMessage, arg.MessageID, arg.RoomID, arg.UserID)
+	var i UpdateLastReadMessageRow
+	err := row.Scan(&i.UserID, &i.RoomID, &i.LastReadMessageID)
+	return i, err
+}
+
+const updateMessageContent = `-- name: UpdateMessageContent :one
+UPDATE
+    chat_messages
+SET content    = $2,
+    updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+RETURNING id, user_id, room_id, message_type, content, reply_to_id, created_at, updated_at
+`
+
+type UpdateMessageContentParams struct {
+	ID      uuid.UUID
+	Content string
+}
+
+type UpdateMessageContentRow struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	RoomID      uuid.UUID
+	MessageType string
+	Content     string
+	ReplyToID   uuid.NullUUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (q *Queries) UpdateMessageContent(ctx context.Context, arg UpdateMessageContentParams) (UpdateMessageContentRow, error) {
+	row := q.db.QueryRowContext(ctx, updateMessageContent, arg.ID, arg.Content)
+	var i UpdateMessageContentRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RoomID,
+		&i.MessageType,
+		&i.Content,
+		&i.ReplyToID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateRoomInfo = `-- name: UpdateRoomInfo :exec
+UPDATE
+    chat_rooms
+SET name       = CASE WHEN $1::boolean THEN $2::text ELSE name END,
+    image_id   = CASE WHEN $3::boolean THEN $4::uuid ELSE image_id END,
+    updated_at = NOW()
+WHERE id = $5
+  AND deleted_at IS NULL
+`
+
+type UpdateRoomInfoParams struct {
+	SetName  bool
+	Name     string
+	SetImage bool
+	ImageID  uuid.NullUUID
+	ID       uuid.UUID
+}
+
+func (q *Queries) UpdateRoomInfo(ctx context.Context, arg UpdateRoomInfoParams) error {
+	_, err := q.db.ExecContext(ctx, updateRoomInfo,
+		arg.SetName,
+		arg.Name,
+		arg.SetImage,
+		arg.ImageID,
+		arg.ID,
+	)
+	return err
+}
+
+const updateRoomMemberRole = `-- name: UpdateRoomMemberRole :exec
+UPDATE
+    user_chat_rooms
+SET role = $3
+WHERE room_id = $1
+  AND user_id = $2
+  AND left_at IS NULL
+`
+
+type UpdateRoomMemberRoleParams struct {
+	RoomID uuid.UUID
+	UserID uuid.UUID
+	Role   string
+}
+
+func (q *Queries) UpdateRoomMemberRole(ctx context.Context, arg UpdateRoomMemberRoleParams) error {
+	_, err := q.db.ExecContext(ctx, updateRoomMemberRole, arg.RoomID, arg.UserID, arg.Role)
+	return err
+}
+
+const updateRoomSOSPost = `-- name: UpdateRoomSOSPost :exec
+UPDATE
+    chat_rooms
+SET sos_post_id = $2,
+    updated_at  = NOW()
+WHERE id = $1
+`
+
+type UpdateRoomSOSPostParams struct {
+	ID        uuid.UUID
+	SosPostID uuid.NullUUID
+}
+
+func (q *Queries) UpdateRoomSOSPost(ctx context.Context, arg UpdateRoomSOSPostParams) error {
+	_, err := q.db.ExecContext(ctx, updateRoomSOSPost, arg.ID, arg.SosPostID)
+	return err
+}
+
 const userExistsInRoom = `-- name: UserExistsInRoom :one
 SELECT EXISTS (SELECT 1
                FROM user_chat_rooms
@@ -610,3 +1356,65 @@ func (q *Queries) UserExistsInRoom(ctx context.Context, roomID uuid.UUID) (bool,
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const writeMessage = `-- name: WriteMessage :one
+INSERT INTO chat_messages
+(id,
+ user_id,
+ room_id,
+ message_type,
+ content,
+ reply_to_id,
+ client_message_id,
+ created_at,
+ updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+-- 같은 사용자가 같은 client_message_id로 재전송하면 저장하지 않는다.
+ON CONFLICT (user_id, client_message_id) DO NOTHING
+RETURNING id, user_id, room_id, message_type, content, reply_to_id, created_at, updated_at
+`
+
+type WriteMessageParams struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	RoomID          uuid.UUID
+	MessageType     string
+	Content         string
+	ReplyToID       uuid.NullUUID
+	ClientMessageID sql.NullString
+}
+
+type WriteMessageRow struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	RoomID      uuid.UUID
+	MessageType string
+	Content     string
+	ReplyToID   uuid.NullUUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (q *Queries) WriteMessage(ctx context.Context, arg WriteMessageParams) (WriteMessageRow, error) {
+	row := q.db.QueryRowContext(ctx, writeMessage,
+		arg.ID,
+		arg.UserID,
+		arg.RoomID,
+		arg.MessageType,
+		arg.Content,
+		arg.ReplyToID,
+		arg.ClientMessageID,
+	)
+	var i WriteMessageRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RoomID,
+		&i.MessageType,
+		&i.Content,
+		&i.ReplyToID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
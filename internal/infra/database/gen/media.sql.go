@@ -13,21 +13,43 @@ import (
 	"github.com/lib/pq"
 )
 
+const countMediasByUploader = `-- name: CountMediasByUploader :one
+SELECT COUNT(*)
+FROM media
+WHERE id = ANY ($1::uuid[])
+  AND uploader_id = $2
+  AND deleted_at IS NULL
+`
+
+type CountMediasByUploaderParams struct {
+	Ids        []uuid.UUID
+	UploaderID uuid.NullUUID
+}
+
+func (q *Queries) CountMediasByUploader(ctx context.Context, arg CountMediasByUploaderParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMediasByUploader, pq.Array(arg.Ids), arg.UploaderID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createMedia = `-- name: CreateMedia :one
 INSERT INTO media
 (id,
  media_type,
  url,
+ uploader_id,
  created_at,
  updated_at)
-VALUES ($1, $2, $3, NOW(), NOW())
+VALUES ($1, $2, $3, $4, NOW(), NOW())
 RETURNING id, media_type, url, created_at, updated_at
 `
 
 type CreateMediaParams struct {
-	ID        uuid.UUID
-	MediaType string
-	Url       string
+	ID         uuid.UUID
+	MediaType  string
+	Url        string
+	UploaderID uuid.NullUUID
 }
 
 type CreateMediaRow struct {
@@ -39,7 +61,12 @@ type CreateMediaRow struct {
 }
 
 func (q *Queries) CreateMedia(ctx context.Context, arg CreateMediaParams) (CreateMediaRow, error) {
-	row := q.db.QueryRowContext(ctx, createMedia, arg.ID, arg.MediaType, arg.Url)
+	row := q.db.QueryRowContext(ctx, createMedia,
+		arg.ID,
+		arg.MediaType,
+		arg.Url,
+		arg.UploaderID,
+	)
 	var i CreateMediaRow
 	err := row.Scan(
 		&i.ID,
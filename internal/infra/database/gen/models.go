@@ -21,15 +21,23 @@ type Breed struct {
 	ID        uuid.UUID
 }
 
+type ChatEnvelope struct {
+	ID        uuid.UUID
+	Envelope  json.RawMessage
+	CreatedAt time.Time
+}
+
 type ChatMessage struct {
-	MessageType string
-	Content     string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   sql.NullTime
-	ID          uuid.UUID
-	UserID      uuid.UUID
-	RoomID      uuid.UUID
+	MessageType     string
+	Content         string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       sql.NullTime
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	RoomID          uuid.UUID
+	ReplyToID       uuid.NullUUID
+	ClientMessageID sql.NullString
 }
 
 type ChatRoom struct {
@@ -39,15 +47,19 @@ type ChatRoom struct {
 	UpdatedAt time.Time
 	DeletedAt sql.NullTime
 	ID        uuid.UUID
+	SosPostID uuid.NullUUID
+	DirectKey sql.NullString
+	ImageID   uuid.NullUUID
 }
 
 type Medium struct {
-	MediaType string
-	Url       string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt sql.NullTime
-	ID        uuid.UUID
+	MediaType  string
+	Url        string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  sql.NullTime
+	ID         uuid.UUID
+	UploaderID uuid.NullUUID
 }
 
 type Pet struct {
@@ -68,6 +80,19 @@ type Pet struct {
 	ProfileImageID uuid.NullUUID
 }
 
+type Report struct {
+	ID             uuid.UUID
+	ReporterID     uuid.UUID
+	ReportedUserID uuid.UUID
+	TargetType     string
+	TargetID       uuid.UUID
+	Reason         string
+	Description    sql.NullString
+	Status         string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
 type ResourceMedium struct {
 	ResourceType sql.NullString
 	CreatedAt    time.Time
@@ -93,21 +118,56 @@ type SosDate struct {
 	UpdatedAt   sql.NullTime
 	DeletedAt   sql.NullTime
 	ID          uuid.UUID
+	TimeSlots   json.RawMessage
+	Timezone    string
+	StartAt     sql.NullTime
 }
 
 type SosPost struct {
+	ID                  uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	AuthorID            uuid.UUID
+	Reward              sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	RewardType          sql.NullString
+	ThumbnailID         uuid.NullUUID
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	DeletedAt           sql.NullTime
+	Status              string
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	ViewCount           int32
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
+}
+
+type SosPostApplication struct {
 	ID          uuid.UUID
-	Title       sql.NullString
-	Content     sql.NullString
-	AuthorID    uuid.UUID
-	Reward      sql.NullString
-	CareType    sql.NullString
-	CarerGender sql.NullString
-	RewardType  sql.NullString
-	ThumbnailID uuid.NullUUID
+	SosPostID   uuid.UUID
+	ApplicantID uuid.UUID
+	Message     string
+	Status      string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
-	DeletedAt   sql.NullTime
+}
+
+type SosPostBookmark struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	SosPostID uuid.UUID
+	CreatedAt time.Time
+}
+
+type SosPostView struct {
+	SosPostID uuid.UUID
+	ViewerKey string
+	ViewedOn  time.Time
+	CreatedAt time.Time
 }
 
 type SosPostsCondition struct {
@@ -149,14 +209,35 @@ type User struct {
 	DeletedAt      sql.NullTime
 	ID             uuid.UUID
 	ProfileImageID uuid.NullUUID
+	Region         sql.NullString
+	Latitude       sql.NullFloat64
+	Longitude      sql.NullFloat64
+}
+
+type UserBlock struct {
+	ID        uuid.UUID
+	BlockerID uuid.UUID
+	BlockedID uuid.UUID
+	CreatedAt time.Time
 }
 
 type UserChatRoom struct {
-	JoinedAt time.Time
-	LeftAt   sql.NullTime
-	ID       uuid.UUID
-	UserID   uuid.UUID
-	RoomID   uuid.UUID
+	JoinedAt          time.Time
+	LeftAt            sql.NullTime
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	RoomID            uuid.UUID
+	LastReadMessageID uuid.NullUUID
+	Role              string
+}
+
+type UserDeviceToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Token     string
+	Platform  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type VCondition struct {
@@ -181,12 +262,23 @@ type VSosPost struct {
 	Content             sql.NullString
 	Reward              sql.NullString
 	RewardType          sql.NullString
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
 	CareType            sql.NullString
 	CarerGender         sql.NullString
 	ThumbnailID         uuid.NullUUID
 	AuthorID            uuid.UUID
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
+	Status              string
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	ViewCount           int32
+	ApplicationCount    int64
+	BookmarkCount       int64
 	EarliestDateStartAt interface{}
+	EarliestStartAt     interface{}
 	Dates               json.RawMessage
 }
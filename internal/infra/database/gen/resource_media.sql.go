@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const createResourceMedia = `-- name: CreateResourceMedia :one
@@ -131,3 +132,66 @@ func (q *Queries) FindResourceMedia(ctx context.Context, arg FindResourceMediaPa
 	}
 	return items, nil
 }
+
+const findResourceMediaByResourceIDs = `-- name: FindResourceMediaByResourceIDs :many
+SELECT rm.resource_id,
+       m.id AS media_id,
+       m.media_type,
+       m.url,
+       m.created_at,
+       m.updated_at
+FROM resource_media rm
+         INNER JOIN
+     media m
+     ON
+         rm.media_id = m.id
+WHERE rm.resource_id = ANY ($1::uuid[])
+  AND rm.resource_type = $2
+  AND rm.deleted_at IS NULL
+  AND m.deleted_at IS NULL
+ORDER BY rm.created_at
+`
+
+type FindResourceMediaByResourceIDsParams struct {
+	ResourceIds  []uuid.UUID
+	ResourceType sql.NullString
+}
+
+type FindResourceMediaByResourceIDsRow struct {
+	ResourceID uuid.UUID
+	MediaID    uuid.UUID
+	MediaType  string
+	Url        string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (q *Queries) FindResourceMediaByResourceIDs(ctx context.Context, arg FindResourceMediaByResourceIDsParams) ([]FindResourceMediaByResourceIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, findResourceMediaByResourceIDs, pq.Array(arg.ResourceIds), arg.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindResourceMediaByResourceIDsRow
+	for rows.Next() {
+		var i FindResourceMediaByResourceIDsRow
+		if err := rows.Scan(
+			&i.ResourceID,
+			&i.MediaID,
+			&i.MediaType,
+			&i.Url,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
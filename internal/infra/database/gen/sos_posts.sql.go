@@ -12,9 +12,23 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sqlc-dev/pqtype"
 )
 
+const deleteSOSPost = `-- name: DeleteSOSPost :exec
+UPDATE
+    sos_posts
+SET deleted_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteSOSPost(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteSOSPost, id)
+	return err
+}
+
 const deleteSOSPostConditionBySOSPostID = `-- name: DeleteSOSPostConditionBySOSPostID :exec
 UPDATE
     sos_posts_conditions
@@ -55,6 +69,8 @@ const findDatesBySOSPostID = `-- name: FindDatesBySOSPostID :many
 SELECT sos_dates.id,
        sos_dates.date_start_at,
        sos_dates.date_end_at,
+       sos_dates.time_slots,
+       sos_dates.timezone,
        sos_dates.created_at,
        sos_dates.updated_at
 FROM sos_dates
@@ -69,6 +85,8 @@ type FindDatesBySOSPostIDRow struct {
 	ID          uuid.UUID
 	DateStartAt sql.NullTime
 	DateEndAt   sql.NullTime
+	TimeSlots   json.RawMessage
+	Timezone    string
 	CreatedAt   sql.NullTime
 	UpdatedAt   sql.NullTime
 }
@@ -86,6 +104,8 @@ func (q *Queries) FindDatesBySOSPostID(ctx context.Context, id uuid.NullUUID) ([
 			&i.ID,
 			&i.DateStartAt,
 			&i.DateEndAt,
+			&i.TimeSlots,
+			&i.Timezone,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -108,12 +128,22 @@ SELECT v_sos_posts.id,
        v_sos_posts.content,
        v_sos_posts.reward,
        v_sos_posts.reward_type,
+       v_sos_posts.reward_amount,
+       v_sos_posts.reward_unit,
+       v_sos_posts.gifticon_description,
        v_sos_posts.care_type,
        v_sos_posts.carer_gender,
        v_sos_posts.thumbnail_id,
        v_sos_posts.author_id,
        v_sos_posts.created_at,
        v_sos_posts.updated_at,
+       v_sos_posts.status,
+       v_sos_posts.region,
+       v_sos_posts.latitude,
+       v_sos_posts.longitude,
+       v_sos_posts.view_count,
+       v_sos_posts.application_count,
+       v_sos_posts.bookmark_count,
        v_sos_posts.dates,
        v_pets_for_sos_posts.pets_info,
        v_media_for_sos_posts.media_info,
@@ -126,21 +156,31 @@ WHERE v_sos_posts.id = $1
 `
 
 type FindSOSPostByIDRow struct {
-	ID             uuid.UUID
-	Title          sql.NullString
-	Content        sql.NullString
-	Reward         sql.NullString
-	RewardType     sql.NullString
-	CareType       sql.NullString
-	CarerGender    sql.NullString
-	ThumbnailID    uuid.NullUUID
-	AuthorID       uuid.UUID
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	Dates          json.RawMessage
-	PetsInfo       pqtype.NullRawMessage
-	MediaInfo      pqtype.NullRawMessage
-	ConditionsInfo pqtype.NullRawMessage
+	ID                  uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	Reward              sql.NullString
+	RewardType          sql.NullString
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	ThumbnailID         uuid.NullUUID
+	AuthorID            uuid.UUID
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Status              string
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	ViewCount           int32
+	ApplicationCount    int64
+	BookmarkCount       int64
+	Dates               json.RawMessage
+	PetsInfo            pqtype.NullRawMessage
+	MediaInfo           pqtype.NullRawMessage
+	ConditionsInfo      pqtype.NullRawMessage
 }
 
 func (q *Queries) FindSOSPostByID(ctx context.Context, id uuid.NullUUID) (FindSOSPostByIDRow, error) {
@@ -152,12 +192,22 @@ func (q *Queries) FindSOSPostByID(ctx context.Context, id uuid.NullUUID) (FindSO
 		&i.Content,
 		&i.Reward,
 		&i.RewardType,
+		&i.RewardAmount,
+		&i.RewardUnit,
+		&i.GifticonDescription,
 		&i.CareType,
 		&i.CarerGender,
 		&i.ThumbnailID,
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.Region,
+		&i.Latitude,
+		&i.Longitude,
+		&i.ViewCount,
+		&i.ApplicationCount,
+		&i.BookmarkCount,
 		&i.Dates,
 		&i.PetsInfo,
 		&i.MediaInfo,
@@ -172,12 +222,22 @@ SELECT v_sos_posts.id,
        v_sos_posts.content,
        v_sos_posts.reward,
        v_sos_posts.reward_type,
+       v_sos_posts.reward_amount,
+       v_sos_posts.reward_unit,
+       v_sos_posts.gifticon_description,
        v_sos_posts.care_type,
        v_sos_posts.carer_gender,
        v_sos_posts.thumbnail_id,
        v_sos_posts.author_id,
        v_sos_posts.created_at,
        v_sos_posts.updated_at,
+       v_sos_posts.status,
+       v_sos_posts.region,
+       v_sos_posts.latitude,
+       v_sos_posts.longitude,
+       v_sos_posts.view_count,
+       v_sos_posts.application_count,
+       v_sos_posts.bookmark_count,
        v_sos_posts.dates,
        v_pets_for_sos_posts.pets_info,
        v_media_for_sos_posts.media_info,
@@ -186,47 +246,194 @@ FROM v_sos_posts
          LEFT JOIN v_pets_for_sos_posts ON v_sos_posts.id = v_pets_for_sos_posts.sos_post_id
          LEFT JOIN v_media_for_sos_posts ON v_sos_posts.id = v_media_for_sos_posts.sos_post_id
          LEFT JOIN v_conditions ON v_sos_posts.id = v_conditions.sos_post_id
-WHERE v_sos_posts.earliest_date_start_at >= $1
-  AND ($2 = 'all' OR NOT EXISTS
+WHERE (v_sos_posts.earliest_date_start_at >= $1 OR $2::boolean)
+  AND ($3 = 'all' OR NOT EXISTS
     (SELECT 1
      FROM unnest(pet_type_list) AS pet_type
-     WHERE pet_type <> $2))
-ORDER BY CASE WHEN $3 = 'newest' THEN v_sos_posts.created_at END DESC,
-         CASE WHEN $3 = 'deadline' THEN v_sos_posts.earliest_date_start_at END
-LIMIT $5 OFFSET $4
+     WHERE pet_type <> $3))
+  -- 조회하는 사용자가 차단한 사용자의 게시글은 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE user_blocks.blocker_id = $4
+                    AND user_blocks.blocked_id = v_sos_posts.author_id)
+  AND ($5::text IS NULL OR v_sos_posts.status = $5::text)
+  -- 행정구역이 주어지면 해당 지역과 하위 지역의 게시글만 조회한다.
+  AND ($6::text IS NULL OR starts_with(v_sos_posts.region, $6::text))
+  -- 반경이 주어지면 기준 좌표에서 반경 안에 있는 게시글만 조회한다.
+  AND ($7::float8 IS NULL OR
+       distance_km(
+           $8::float8, $9::float8,
+           v_sos_posts.latitude, v_sos_posts.longitude
+       ) <= $7::float8)
+  AND ($10::text IS NULL OR v_sos_posts.care_type = $10::text)
+  AND ($11::text IS NULL OR v_sos_posts.reward_type = $11::text)
+  -- 사례 금액 범위가 주어지면 금액이 정해진 게시글 중 범위 안에 있는 게시글만 조회한다.
+  AND ($12::text IS NULL OR v_sos_posts.reward_unit = $12::text)
+  AND ($13::int IS NULL OR
+       v_sos_posts.reward_amount >= $13::int)
+  AND ($14::int IS NULL OR
+       v_sos_posts.reward_amount <= $14::int)
+  -- 돌보미 성별이 주어지면 해당 성별 또는 성별 무관인 게시글을 조회한다.
+  AND ($15::text IS NULL OR
+       v_sos_posts.carer_gender IN ($15::text, 'all'))
+  -- 주어진 돌봄 조건을 모두 포함하는 게시글만 조회한다.
+  AND ($16::uuid[] IS NULL OR NOT EXISTS
+    (SELECT 1
+     FROM unnest($16::uuid[]) AS condition_id
+     WHERE condition_id NOT IN (SELECT sos_posts_conditions.sos_condition_id
+                                FROM sos_posts_conditions
+                                WHERE sos_posts_conditions.sos_post_id = v_sos_posts.id
+                                  AND sos_posts_conditions.deleted_at IS NULL)))
+  -- 돌봄 날짜 중 하나라도 주어진 기간, 시간대와 겹치는 게시글만 조회한다.
+  -- 돌봄 시간대가 없는 날짜는 하루 종일 돌봄이 필요하므로 모든 시간대와 겹친다.
+  AND (($17::date IS NULL AND $18::date IS NULL AND
+        $19::text IS NULL AND $20::text IS NULL) OR EXISTS
+    (SELECT 1
+     FROM sos_posts_dates
+              INNER JOIN sos_dates ON sos_posts_dates.sos_dates_id = sos_dates.id
+     WHERE sos_posts_dates.sos_post_id = v_sos_posts.id
+       AND sos_posts_dates.deleted_at IS NULL
+       AND sos_dates.deleted_at IS NULL
+       AND ($18::date IS NULL OR sos_dates.date_start_at <= $18::date)
+       AND ($17::date IS NULL OR sos_dates.date_end_at >= $17::date)
+       AND (jsonb_array_length(sos_dates.time_slots) = 0 OR EXISTS
+         (SELECT 1
+          FROM jsonb_array_elements(sos_dates.time_slots) AS time_slot
+          WHERE ($20::text IS NULL OR
+                 (time_slot ->> 'startTime')::time < $20::text::time)
+            AND ($19::text IS NULL OR
+                 (time_slot ->> 'endTime')::time > $19::text::time)))))
+  AND ($21::text IS NULL OR EXISTS
+    (SELECT 1
+     FROM sos_posts_pets
+              INNER JOIN pets ON sos_posts_pets.pet_id = pets.id
+     WHERE sos_posts_pets.sos_post_id = v_sos_posts.id
+       AND sos_posts_pets.deleted_at IS NULL
+       AND pets.breed = $21::text))
+  -- 검색어가 주어지면 제목 또는 내용에 검색어를 포함하는 게시글만 조회한다.
+  AND ($22::text IS NULL OR v_sos_posts.id IN
+    (SELECT sos_posts.id
+     FROM sos_posts
+     WHERE sos_posts.title ILIKE '%' || $22::text || '%'
+        OR sos_posts.content ILIKE '%' || $22::text || '%'))
+  -- 커서가 주어지면 정렬 기준에 따라 커서 다음 게시글부터 조회한다.
+  AND ($23::uuid IS NULL OR
+       ($24 = 'newest' AND
+        (v_sos_posts.created_at, v_sos_posts.id) <
+        ($25::timestamp, $23::uuid)) OR
+       -- 돌봄 시작 시각이 없는 게시글은 마지막에 정렬되므로 시작 시각이 있는 커서 다음에 온다.
+       ($24 = 'deadline' AND
+        (v_sos_posts.earliest_start_at > $26::timestamptz OR
+         (v_sos_posts.earliest_start_at IS NULL AND $26::timestamptz IS NOT NULL) OR
+         (v_sos_posts.earliest_start_at IS NOT DISTINCT FROM $26::timestamptz AND
+          v_sos_posts.id < $23::uuid))))
+ORDER BY CASE WHEN $24 = 'newest' THEN v_sos_posts.created_at END DESC,
+         CASE WHEN $24 = 'deadline' THEN v_sos_posts.earliest_start_at END NULLS LAST,
+         CASE
+             WHEN $24 = 'nearest' THEN distance_km(
+                 $8::float8, $9::float8,
+                 v_sos_posts.latitude, v_sos_posts.longitude
+             ) END,
+         -- 제목에서 일치하는 게시글을 내용에서 일치하는 게시글보다 앞에 둔다.
+         CASE
+             WHEN $24 = 'relevance' THEN
+                 word_similarity($22::text, v_sos_posts.title) * 2 +
+                 word_similarity($22::text, v_sos_posts.content) END DESC,
+         -- 지원과 저장은 조회보다 관심이 크다고 보고 가중치를 둔다.
+         CASE
+             WHEN $24 = 'popular' THEN
+                 v_sos_posts.view_count + v_sos_posts.application_count * 5 + v_sos_posts.bookmark_count * 3 END DESC,
+         v_sos_posts.id DESC
+LIMIT $28 OFFSET $27
 `
 
 type FindSOSPostsParams struct {
 	EarliestDateStartAt interface{}
+	IncludePast         bool
 	PetType             interface{}
+	ViewerID            uuid.NullUUID
+	Status              sql.NullString
+	Region              sql.NullString
+	RadiusKm            sql.NullFloat64
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	CareType            sql.NullString
+	RewardType          sql.NullString
+	RewardUnit          sql.NullString
+	MinRewardAmount     sql.NullInt32
+	MaxRewardAmount     sql.NullInt32
+	CarerGender         sql.NullString
+	ConditionIds        []uuid.UUID
+	DateFrom            sql.NullTime
+	DateTo              sql.NullTime
+	TimeFrom            sql.NullString
+	TimeTo              sql.NullString
+	Breed               sql.NullString
+	Keyword             sql.NullString
+	CursorID            uuid.NullUUID
 	SortBy              interface{}
+	CursorCreatedAt     sql.NullTime
+	CursorStartAt       sql.NullTime
 	Offset              sql.NullInt32
 	Limit               sql.NullInt32
 }
 
 type FindSOSPostsRow struct {
-	ID             uuid.UUID
-	Title          sql.NullString
-	Content        sql.NullString
-	Reward         sql.NullString
-	RewardType     sql.NullString
-	CareType       sql.NullString
-	CarerGender    sql.NullString
-	ThumbnailID    uuid.NullUUID
-	AuthorID       uuid.UUID
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	Dates          json.RawMessage
-	PetsInfo       pqtype.NullRawMessage
-	MediaInfo      pqtype.NullRawMessage
-	ConditionsInfo pqtype.NullRawMessage
+	ID                  uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	Reward              sql.NullString
+	RewardType          sql.NullString
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	ThumbnailID         uuid.NullUUID
+	AuthorID            uuid.UUID
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Status              string
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	ViewCount           int32
+	ApplicationCount    int64
+	BookmarkCount       int64
+	Dates               json.RawMessage
+	PetsInfo            pqtype.NullRawMessage
+	MediaInfo           pqtype.NullRawMessage
+	ConditionsInfo      pqtype.NullRawMessage
 }
 
 func (q *Queries) FindSOSPosts(ctx context.Context, arg FindSOSPostsParams) ([]FindSOSPostsRow, error) {
 	rows, err := q.db.QueryContext(ctx, findSOSPosts,
 		arg.EarliestDateStartAt,
+		arg.IncludePast,
 		arg.PetType,
+		arg.ViewerID,
+		arg.Status,
+		arg.Region,
+		arg.RadiusKm,
+		arg.Latitude,
+		arg.Longitude,
+		arg.CareType,
+		arg.RewardType,
+		arg.RewardUnit,
+		arg.MinRewardAmount,
+		arg.MaxRewardAmount,
+		arg.CarerGender,
+		pq.Array(arg.ConditionIds),
+		arg.DateFrom,
+		arg.DateTo,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Breed,
+		arg.Keyword,
+		arg.CursorID,
 		arg.SortBy,
+		arg.CursorCreatedAt,
+		arg.CursorStartAt,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -243,12 +450,22 @@ func (q *Queries) FindSOSPosts(ctx context.Context, arg FindSOSPostsParams) ([]F
 			&i.Content,
 			&i.Reward,
 			&i.RewardType,
+			&i.RewardAmount,
+			&i.RewardUnit,
+			&i.GifticonDescription,
 			&i.CareType,
 			&i.CarerGender,
 			&i.ThumbnailID,
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.Region,
+			&i.Latitude,
+			&i.Longitude,
+			&i.ViewCount,
+			&i.ApplicationCount,
+			&i.BookmarkCount,
 			&i.Dates,
 			&i.PetsInfo,
 			&i.MediaInfo,
@@ -273,12 +490,22 @@ SELECT v_sos_posts.id,
        v_sos_posts.content,
        v_sos_posts.reward,
        v_sos_posts.reward_type,
+       v_sos_posts.reward_amount,
+       v_sos_posts.reward_unit,
+       v_sos_posts.gifticon_description,
        v_sos_posts.care_type,
        v_sos_posts.carer_gender,
        v_sos_posts.thumbnail_id,
        v_sos_posts.author_id,
        v_sos_posts.created_at,
        v_sos_posts.updated_at,
+       v_sos_posts.status,
+       v_sos_posts.region,
+       v_sos_posts.latitude,
+       v_sos_posts.longitude,
+       v_sos_posts.view_count,
+       v_sos_posts.application_count,
+       v_sos_posts.bookmark_count,
        v_sos_posts.dates,
        v_pets_for_sos_posts.pets_info,
        v_media_for_sos_posts.media_info,
@@ -293,36 +520,52 @@ WHERE v_sos_posts.earliest_date_start_at >= $1
     (SELECT 1
      FROM unnest(pet_type_list) AS pet_type
      WHERE pet_type <> $3))
-ORDER BY CASE WHEN $4 = 'newest' THEN v_sos_posts.created_at END DESC,
-         CASE WHEN $4 = 'deadline' THEN v_sos_posts.earliest_date_start_at END
-LIMIT $6 OFFSET $5
+  AND ($4::text IS NULL OR v_sos_posts.status = $4::text)
+ORDER BY CASE WHEN $5 = 'newest' THEN v_sos_posts.created_at END DESC,
+         CASE WHEN $5 = 'deadline' THEN v_sos_posts.earliest_start_at END NULLS LAST,
+         CASE
+             WHEN $5 = 'popular' THEN
+                 v_sos_posts.view_count + v_sos_posts.application_count * 5 + v_sos_posts.bookmark_count * 3 END DESC,
+         v_sos_posts.id DESC
+LIMIT $7 OFFSET $6
 `
 
 type FindSOSPostsByAuthorIDParams struct {
 	EarliestDateStartAt interface{}
 	AuthorID            uuid.NullUUID
 	PetType             interface{}
+	Status              sql.NullString
 	SortBy              interface{}
 	Offset              sql.NullInt32
 	Limit               sql.NullInt32
 }
 
 type FindSOSPostsByAuthorIDRow struct {
-	ID             uuid.UUID
-	Title          sql.NullString
-	Content        sql.NullString
-	Reward         sql.NullString
-	RewardType     sql.NullString
-	CareType       sql.NullString
-	CarerGender    sql.NullString
-	ThumbnailID    uuid.NullUUID
-	AuthorID       uuid.UUID
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	Dates          json.RawMessage
-	PetsInfo       pqtype.NullRawMessage
-	MediaInfo      pqtype.NullRawMessage
-	ConditionsInfo pqtype.NullRawMessage
+	ID                  uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	Reward              sql.NullString
+	RewardType          sql.NullString
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	ThumbnailID         uuid.NullUUID
+	AuthorID            uuid.UUID
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Status              string
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	ViewCount           int32
+	ApplicationCount    int64
+	BookmarkCount       int64
+	Dates               json.RawMessage
+	PetsInfo            pqtype.NullRawMessage
+	MediaInfo           pqtype.NullRawMessage
+	ConditionsInfo      pqtype.NullRawMessage
 }
 
 func (q *Queries) FindSOSPostsByAuthorID(ctx context.Context, arg FindSOSPostsByAuthorIDParams) ([]FindSOSPostsByAuthorIDRow, error) {
@@ -330,6 +573,7 @@ func (q *Queries) FindSOSPostsByAuthorID(ctx context.Context, arg FindSOSPostsBy
 		arg.EarliestDateStartAt,
 		arg.AuthorID,
 		arg.PetType,
+		arg.Status,
 		arg.SortBy,
 		arg.Offset,
 		arg.Limit,
@@ -347,12 +591,22 @@ func (q *Queries) FindSOSPostsByAuthorID(ctx context.Context, arg FindSOSPostsBy
 			&i.Content,
 			&i.Reward,
 			&i.RewardType,
+			&i.RewardAmount,
+			&i.RewardUnit,
+			&i.GifticonDescription,
 			&i.CareType,
 			&i.CarerGender,
 			&i.ThumbnailID,
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.Region,
+			&i.Latitude,
+			&i.Longitude,
+			&i.ViewCount,
+			&i.ApplicationCount,
+			&i.BookmarkCount,
 			&i.Dates,
 			&i.PetsInfo,
 			&i.MediaInfo,
@@ -371,14 +625,43 @@ func (q *Queries) FindSOSPostsByAuthorID(ctx context.Context, arg FindSOSPostsBy
 	return items, nil
 }
 
+const increaseSOSPostViewCount = `-- name: IncreaseSOSPostViewCount :exec
+WITH inserted AS (
+    -- 같은 조회자가 같은 날 다시 조회한 경우에는 기록하지 않으므로 조회수도 오르지 않는다.
+    INSERT INTO sos_post_views
+        (sos_post_id,
+         viewer_key,
+         viewed_on,
+         created_at)
+        VALUES ($1, $2, CURRENT_DATE, NOW())
+        ON CONFLICT (sos_post_id, viewer_key, viewed_on) DO NOTHING
+        RETURNING sos_post_id)
+UPDATE sos_posts
+SET view_count = view_count + 1
+WHERE id IN (SELECT sos_post_id FROM inserted)
+`
+
+type IncreaseSOSPostViewCountParams struct {
+	SosPostID uuid.UUID
+	ViewerKey string
+}
+
+func (q *Queries) IncreaseSOSPostViewCount(ctx context.Context, arg IncreaseSOSPostViewCountParams) error {
+	_, err := q.db.ExecContext(ctx, increaseSOSPostViewCount, arg.SosPostID, arg.ViewerKey)
+	return err
+}
+
 const insertSOSDate = `-- name: InsertSOSDate :one
 INSERT INTO sos_dates
 (id,
  date_start_at,
  date_end_at,
+ time_slots,
+ timezone,
+ start_at,
  created_at,
  updated_at)
-VALUES ($1, $2, $3, NOW(), NOW())
+VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
 RETURNING id, date_start_at, date_end_at, created_at, updated_at
 `
 
@@ -386,6 +669,9 @@ type InsertSOSDateParams struct {
 	ID          uuid.UUID
 	DateStartAt sql.NullTime
 	DateEndAt   sql.NullTime
+	TimeSlots   json.RawMessage
+	Timezone    string
+	StartAt     sql.NullTime
 }
 
 type InsertSOSDateRow struct {
@@ -397,7 +683,14 @@ type InsertSOSDateRow struct {
 }
 
 func (q *Queries) InsertSOSDate(ctx context.Context, arg InsertSOSDateParams) (InsertSOSDateRow, error) {
-	row := q.db.QueryRowContext(ctx, insertSOSDate, arg.ID, arg.DateStartAt, arg.DateEndAt)
+	row := q.db.QueryRowContext(ctx, insertSOSDate,
+		arg.ID,
+		arg.DateStartAt,
+		arg.DateEndAt,
+		arg.TimeSlots,
+		arg.Timezone,
+		arg.StartAt,
+	)
 	var i InsertSOSDateRow
 	err := row.Scan(
 		&i.ID,
@@ -503,42 +796,62 @@ func (q *Queries) LinkSOSPostPet(ctx context.Context, arg LinkSOSPostPetParams)
 const updateSOSPost = `-- name: UpdateSOSPost :one
 UPDATE
     sos_posts
-SET title        = $1,
-    content      = $2,
-    reward       = $3,
-    care_type    = $4,
-    carer_gender = $5,
-    reward_type  = $6,
-    thumbnail_id = $7,
-    updated_at   = NOW()
-WHERE id = $8
+SET title                = $1,
+    content              = $2,
+    reward               = $3,
+    care_type            = $4,
+    carer_gender         = $5,
+    reward_type          = $6,
+    thumbnail_id         = $7,
+    region               = $8,
+    latitude             = $9,
+    longitude            = $10,
+    reward_amount        = $11,
+    reward_unit          = $12,
+    gifticon_description = $13,
+    updated_at           = NOW()
+WHERE id = $14
 RETURNING
-    id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at
+    id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at,
+    status, region, latitude, longitude, reward_amount, reward_unit, gifticon_description
 `
 
 type UpdateSOSPostParams struct {
-	Title       sql.NullString
-	Content     sql.NullString
-	Reward      sql.NullString
-	CareType    sql.NullString
-	CarerGender sql.NullString
-	RewardType  sql.NullString
-	ThumbnailID uuid.NullUUID
-	ID          uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	Reward              sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	RewardType          sql.NullString
+	ThumbnailID         uuid.NullUUID
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
+	ID                  uuid.UUID
 }
 
 type UpdateSOSPostRow struct {
-	ID          uuid.UUID
-	AuthorID    uuid.UUID
-	Title       sql.NullString
-	Content     sql.NullString
-	Reward      sql.NullString
-	CareType    sql.NullString
-	CarerGender sql.NullString
-	RewardType  sql.NullString
-	ThumbnailID uuid.NullUUID
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                  uuid.UUID
+	AuthorID            uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	Reward              sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	RewardType          sql.NullString
+	ThumbnailID         uuid.NullUUID
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Status              string
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
 }
 
 func (q *Queries) UpdateSOSPost(ctx context.Context, arg UpdateSOSPostParams) (UpdateSOSPostRow, error) {
@@ -550,6 +863,12 @@ func (q *Queries) UpdateSOSPost(ctx context.Context, arg UpdateSOSPostParams) (U
 		arg.CarerGender,
 		arg.RewardType,
 		arg.ThumbnailID,
+		arg.Region,
+		arg.Latitude,
+		arg.Longitude,
+		arg.RewardAmount,
+		arg.RewardUnit,
+		arg.GifticonDescription,
 		arg.ID,
 	)
 	var i UpdateSOSPostRow
@@ -565,10 +884,48 @@ func (q *Queries) UpdateSOSPost(ctx context.Context, arg UpdateSOSPostParams) (U
 		&i.ThumbnailID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.Region,
+		&i.Latitude,
+		&i.Longitude,
+		&i.RewardAmount,
+		&i.RewardUnit,
+		&i.GifticonDescription,
 	)
 	return i, err
 }
 
+const updateSOSPostStatus = `-- name: UpdateSOSPostStatus :one
+UPDATE
+    sos_posts
+SET status     = $1,
+    updated_at = NOW()
+WHERE id = $2
+  -- 다른 요청이 먼저 상태를 바꾼 경우에는 변경하지 않는다.
+  AND status = $3
+  AND deleted_at IS NULL
+RETURNING id, status, updated_at
+`
+
+type UpdateSOSPostStatusParams struct {
+	Status        string
+	ID            uuid.UUID
+	CurrentStatus string
+}
+
+type UpdateSOSPostStatusRow struct {
+	ID        uuid.UUID
+	Status    string
+	UpdatedAt time.Time
+}
+
+func (q *Queries) UpdateSOSPostStatus(ctx context.Context, arg UpdateSOSPostStatusParams) (UpdateSOSPostStatusRow, error) {
+	row := q.db.QueryRowContext(ctx, updateSOSPostStatus, arg.Status, arg.ID, arg.CurrentStatus)
+	var i UpdateSOSPostStatusRow
+	err := row.Scan(&i.ID, &i.Status, &i.UpdatedAt)
+	return i, err
+}
+
 const writeSOSPost = `-- name: WriteSOSPost :one
 INSERT INTO sos_posts
 (id,
@@ -580,36 +937,56 @@ INSERT INTO sos_posts
  carer_gender,
  reward_type,
  thumbnail_id,
+ region,
+ latitude,
+ longitude,
+ reward_amount,
+ reward_unit,
+ gifticon_description,
  created_at,
  updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
-RETURNING id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NOW(), NOW())
+RETURNING id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at,
+    status, region, latitude, longitude, reward_amount, reward_unit, gifticon_description
 `
 
 type WriteSOSPostParams struct {
-	ID          uuid.UUID
-	AuthorID    uuid.UUID
-	Title       sql.NullString
-	Content     sql.NullString
-	Reward      sql.NullString
-	CareType    sql.NullString
-	CarerGender sql.NullString
-	RewardType  sql.NullString
-	ThumbnailID uuid.NullUUID
+	ID                  uuid.UUID
+	AuthorID            uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	Reward              sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	RewardType          sql.NullString
+	ThumbnailID         uuid.NullUUID
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
 }
 
 type WriteSOSPostRow struct {
-	ID          uuid.UUID
-	AuthorID    uuid.UUID
-	Title       sql.NullString
-	Content     sql.NullString
-	Reward      sql.NullString
-	CareType    sql.NullString
-	CarerGender sql.NullString
-	RewardType  sql.NullString
-	ThumbnailID uuid.NullUUID
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                  uuid.UUID
+	AuthorID            uuid.UUID
+	Title               sql.NullString
+	Content             sql.NullString
+	Reward              sql.NullString
+	CareType            sql.NullString
+	CarerGender         sql.NullString
+	RewardType          sql.NullString
+	ThumbnailID         uuid.NullUUID
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Status              string
+	Region              sql.NullString
+	Latitude            sql.NullFloat64
+	Longitude           sql.NullFloat64
+	RewardAmount        sql.NullInt32
+	RewardUnit          sql.NullString
+	GifticonDescription sql.NullString
 }
 
 func (q *Queries) WriteSOSPost(ctx context.Context, arg WriteSOSPostParams) (WriteSOSPostRow, error) {
@@ -623,6 +1000,12 @@ func (q *Queries) WriteSOSPost(ctx context.Context, arg WriteSOSPostParams) (Wri
 		arg.CarerGender,
 		arg.RewardType,
 		arg.ThumbnailID,
+		arg.Region,
+		arg.Latitude,
+		arg.Longitude,
+		arg.RewardAmount,
+		arg.RewardUnit,
+		arg.GifticonDescription,
 	)
 	var i WriteSOSPostRow
 	err := row.Scan(
@@ -637,6 +1020,13 @@ func (q *Queries) WriteSOSPost(ctx context.Context, arg WriteSOSPostParams) (Wri
 		&i.ThumbnailID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.Region,
+		&i.Latitude,
+		&i.Longitude,
+		&i.RewardAmount,
+		&i.RewardUnit,
+		&i.GifticonDescription,
 	)
 	return i, err
 }
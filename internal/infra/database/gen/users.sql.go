@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const createUser = `-- name: CreateUser :one
@@ -119,7 +120,10 @@ SELECT users.id,
        users.fb_uid,
        users.created_at,
        users.updated_at,
-       users.deleted_at
+       users.deleted_at,
+       users.region,
+       users.latitude,
+       users.longitude
 FROM users
          LEFT OUTER JOIN
      media
@@ -152,6 +156,9 @@ type FindUserRow struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	DeletedAt       sql.NullTime
+	Region          sql.NullString
+	Latitude        sql.NullFloat64
+	Longitude       sql.NullFloat64
 }
 
 func (q *Queries) FindUser(ctx context.Context, arg FindUserParams) (FindUserRow, error) {
@@ -174,6 +181,9 @@ func (q *Queries) FindUser(ctx context.Context, arg FindUserParams) (FindUserRow
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Region,
+		&i.Latitude,
+		&i.Longitude,
 	)
 	return i, err
 }
@@ -243,6 +253,47 @@ func (q *Queries) FindUsers(ctx context.Context, arg FindUsersParams) ([]FindUse
 	return items, nil
 }
 
+const findUsersByIDs = `-- name: FindUsersByIDs :many
+SELECT users.id,
+       users.nickname,
+       media.url AS profile_image_url
+FROM users
+         LEFT OUTER JOIN
+     media
+     ON
+         users.profile_image_id = media.id
+WHERE users.id = ANY ($1::uuid[])
+`
+
+type FindUsersByIDsRow struct {
+	ID              uuid.UUID
+	Nickname        string
+	ProfileImageUrl sql.NullString
+}
+
+func (q *Queries) FindUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]FindUsersByIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, findUsersByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindUsersByIDsRow
+	for rows.Next() {
+		var i FindUsersByIDsRow
+		if err := rows.Scan(&i.ID, &i.Nickname, &i.ProfileImageUrl); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUserByFbUID = `-- name: UpdateUserByFbUID :one
 UPDATE
     users
@@ -297,3 +348,31 @@ func (q *Queries) UpdateUserByFbUID(ctx context.Context, arg UpdateUserByFbUIDPa
 	)
 	return i, err
 }
+
+const updateUserLocationByFbUID = `-- name: UpdateUserLocationByFbUID :exec
+UPDATE
+    users
+SET region     = $1,
+    latitude   = $2,
+    longitude  = $3,
+    updated_at = NOW()
+WHERE fb_uid = $4
+  AND deleted_at IS NULL
+`
+
+type UpdateUserLocationByFbUIDParams struct {
+	Region    sql.NullString
+	Latitude  sql.NullFloat64
+	Longitude sql.NullFloat64
+	FbUid     sql.NullString
+}
+
+func (q *Queries) UpdateUserLocationByFbUID(ctx context.Context, arg UpdateUserLocationByFbUIDParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserLocationByFbUID,
+		arg.Region,
+		arg.Latitude,
+		arg.Longitude,
+		arg.FbUid,
+	)
+	return err
+}
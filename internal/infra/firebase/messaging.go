@@ -0,0 +1,109 @@
+package firebaseinfra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"firebase.google.com/go/messaging"
+)
+
+// PushMessage 기기로 보낼 푸시 알림
+type PushMessage struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// Notifier 기기 토큰으로 푸시 알림을 보낸다.
+type Notifier interface {
+	// Send 알림을 보내고, 만료되었거나 잘못되어 더 이상 사용할 수 없는 토큰 목록을 반환한다.
+	Send(ctx context.Context, tokens []string, message PushMessage) (invalidTokens []string, err error)
+}
+
+// FCMNotifier Firebase Cloud Messaging으로 푸시 알림을 보낸다.
+type FCMNotifier struct {
+	client *messaging.Client
+}
+
+func NewFCMNotifier(ctx context.Context, app *FirebaseApp) (*FCMNotifier, error) {
+	client, err := app.Messaging(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing messaging client: %w", err)
+	}
+
+	return &FCMNotifier{client: client}, nil
+}
+
+// Send 토큰마다 따로 보낸다. 멀티캐스트 API가 사용하던 일괄 전송 엔드포인트는 더 이상 제공되지 않는다.
+func (n *FCMNotifier) Send(ctx context.Context, tokens []string, message PushMessage) ([]string, error) {
+	invalidTokens := make([]string, 0)
+	var errs []error
+	for _, token := range tokens {
+		_, err := n.client.Send(ctx, &messaging.Message{
+			Token: token,
+			Data:  message.Data,
+			Notification: &messaging.Notification{
+				Title: message.Title,
+				Body:  message.Body,
+			},
+		})
+		if err == nil {
+			continue
+		}
+		if messaging.IsRegistrationTokenNotRegistered(err) || messaging.IsInvalidArgument(err) {
+			invalidTokens = append(invalidTokens, token)
+			continue
+		}
+		errs = append(errs, err)
+	}
+
+	return invalidTokens, errors.Join(errs...)
+}
+
+// SentPush InMemoryNotifier가 기록한 알림
+type SentPush struct {
+	Tokens  []string
+	Message PushMessage
+}
+
+// InMemoryNotifier 알림을 보내지 않고 기록만 한다. 로컬 개발과 테스트에서 사용한다.
+type InMemoryNotifier struct {
+	mu   sync.Mutex
+	sent []SentPush
+	// 이 토큰으로 보내면 유효하지 않은 토큰으로 처리한다.
+	InvalidTokens map[string]bool
+}
+
+func NewInMemoryNotifier() *InMemoryNotifier {
+	return &InMemoryNotifier{InvalidTokens: make(map[string]bool)}
+}
+
+func (n *InMemoryNotifier) Send(_ context.Context, tokens []string, message PushMessage) ([]string, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	invalidTokens := make([]string, 0)
+	validTokens := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if n.InvalidTokens[token] {
+			invalidTokens = append(invalidTokens, token)
+			continue
+		}
+		validTokens = append(validTokens, token)
+	}
+
+	if len(validTokens) > 0 {
+		n.sent = append(n.sent, SentPush{Tokens: validTokens, Message: message})
+	}
+	return invalidTokens, nil
+}
+
+// Sent 지금까지 기록된 알림 목록
+func (n *InMemoryNotifier) Sent() []SentPush {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return append([]SentPush(nil), n.sent...)
+}
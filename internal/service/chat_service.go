@@ -2,14 +2,19 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 
 	pnd "github.com/pet-sitter/pets-next-door-api/api"
 	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
+	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/resourcemedia"
 	"github.com/pet-sitter/pets-next-door-api/internal/infra/database"
 	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
 )
@@ -64,10 +69,12 @@ func (s *ChatService) CreateRoom(
 		return nil, pnd.ErrUnknown(fmt.Errorf("failed to generate UUID: %w", joinRoomUUIDError))
 	}
 
+	// 채팅방을 만든 사용자가 방장이 된다.
 	_, err = q.JoinRoom(ctx, databasegen.JoinRoomParams{
 		ID:     joinRoomUUID,
 		UserID: userData.ID,
 		RoomID: row.ID,
+		Role:   string(chat.OwnerRole),
 	})
 	if err != nil {
 		return nil, err
@@ -80,6 +87,130 @@ func (s *ChatService) CreateRoom(
 	return chat.ToCreateRoom(row, chat.ToJoinUsers(userData)), nil
 }
 
+// 두 사용자 사이의 1:1 채팅방을 조회하고, 없으면 생성한다.
+// 채팅방을 나간 사용자가 있으면 다시 참여시키고, SOS 게시글이 주어지면 채팅방에 연결한다.
+func (s *ChatService) FindOrCreateDirectRoom(
+	ctx context.Context,
+	userID, otherUserID uuid.UUID,
+	sosPostID uuid.NullUUID,
+) (*chat.RoomSimpleInfo, error) {
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	room, err := findOrCreateDirectRoom(ctx, databasegen.New(tx), userID, otherUserID, sosPostID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return room, nil
+}
+
+// 주어진 트랜잭션 안에서 1:1 채팅방을 조회하거나 생성한다.
+func findOrCreateDirectRoom(
+	ctx context.Context,
+	q *databasegen.Queries,
+	userID, otherUserID uuid.UUID,
+	sosPostID uuid.NullUUID,
+) (*chat.RoomSimpleInfo, error) {
+	if userID == otherUserID {
+		return nil, pnd.ErrBadRequest(errors.New("cannot create a direct room with yourself"))
+	}
+
+	if _, err := q.FindUser(ctx, databasegen.FindUserParams{
+		ID: uuid.NullUUID{UUID: otherUserID, Valid: true},
+	}); err != nil {
+		return nil, err
+	}
+
+	// 어느 한쪽이라도 상대를 차단했다면 1:1 채팅방에 참여할 수 없다.
+	blocked, err := q.ExistsBlockBetween(ctx, databasegen.ExistsBlockBetweenParams{
+		UserID:      userID,
+		OtherUserID: otherUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, pnd.ErrUserBlocked(errors.New("cannot chat with a blocked user"))
+	}
+
+	directKey := utils.StrToNullStr(chat.DirectRoomKey(userID, otherUserID))
+	if _, err := q.CreateDirectRoom(ctx, databasegen.CreateDirectRoomParams{
+		ID:        datatype.NewUUIDV7(),
+		Name:      "",
+		RoomType:  chat.DirectRoomType,
+		SosPostID: sosPostID,
+		DirectKey: directKey,
+	}); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		// 이미 채팅방이 있으면 아무것도 반환되지 않는다.
+		return nil, err
+	}
+
+	row, err := q.FindRoomByDirectKey(ctx, directKey)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := q.FindUserIDsByRoomID(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range []uuid.UUID{userID, otherUserID} {
+		if slices.Contains(memberIDs, id) {
+			continue
+		}
+		if _, err := q.JoinRoom(ctx, databasegen.JoinRoomParams{
+			ID:     datatype.NewUUIDV7(),
+			UserID: id,
+			RoomID: row.ID,
+			Role:   string(chat.MemberRole),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if sosPostID.Valid && row.SosPostID != sosPostID {
+		if err := q.UpdateRoomSOSPost(ctx, databasegen.UpdateRoomSOSPostParams{
+			ID:        row.ID,
+			SosPostID: sosPostID,
+		}); err != nil {
+			return nil, err
+		}
+		row.SosPostID = sosPostID
+	}
+
+	return chat.ToDirectRoomView(row), nil
+}
+
+// 두 사용자 사이의 1:1 채팅방을 조회한다.
+func (s *ChatService) FindDirectRoom(
+	ctx context.Context, userID, otherUserID uuid.UUID,
+) (*chat.RoomSimpleInfo, error) {
+	q := databasegen.New(s.conn)
+	row, err := q.FindRoomByDirectKey(ctx, utils.StrToNullStr(chat.DirectRoomKey(userID, otherUserID)))
+	if err != nil {
+		return nil, err
+	}
+
+	// 채팅방을 나간 경우에는 조회할 수 없다.
+	found, err := q.FindRoomByIDAndUserID(ctx, databasegen.FindRoomByIDAndUserIDParams{
+		ID:     row.ID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chat.ToUserChatRoomView(found), nil
+}
+
 func (s *ChatService) JoinRoom(
 	ctx context.Context,
 	roomID uuid.UUID,
@@ -92,44 +223,65 @@ func (s *ChatService) JoinRoom(
 		return nil, err
 	}
 	// 채팅방이 현재 존재하는지 확인
-	existRoom, err := databasegen.New(s.conn).ExistsRoom(ctx, roomID)
+	roomType, err := databasegen.New(s.conn).FindRoomTypeByID(ctx, roomID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, pnd.ErrBadRequest(errors.New("chat room does not exist"))
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if !existRoom {
-		return nil, pnd.ErrBadRequest(errors.New("chat room does not exist"))
+	// 1:1 채팅방은 FindOrCreateDirectRoom으로만 참여할 수 있다.
+	if roomType == chat.DirectRoomType {
+		return nil, pnd.ErrBadRequest(errors.New("cannot join a direct room"))
 	}
 
-	// 채팅방에 이미 참여중인지 확인
-	existsUser, existsUserError := databasegen.New(s.conn).UserExistsInRoom(ctx, roomID)
-	if existsUserError != nil {
+	// 요청한 사용자가 채팅방에 이미 참여중인지 확인
+	existsUser, err := databasegen.New(s.conn).ExistsUserInRoom(ctx, databasegen.ExistsUserInRoomParams{
+		RoomID: roomID,
+		UserID: userData.ID,
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// 채팅방에 참여하지 않은 경우 참여
-	if !existsUser {
-		joinRoomUUID, joinRoomUUIDError := uuid.NewV7()
-
-		if joinRoomUUIDError != nil {
-			return nil, pnd.ErrUnknown(fmt.Errorf("failed to generate UUID: %w", joinRoomUUIDError))
-		}
+	if existsUser {
+		return nil, pnd.ErrBadRequest(errors.New("user already joined in the room"))
+	}
 
-		row, err := databasegen.New(s.conn).JoinRoom(ctx, databasegen.JoinRoomParams{
-			ID:     joinRoomUUID,
-			RoomID: roomID,
-			UserID: userData.ID,
+	// 참여자 중 한 명이라도 요청한 사용자와 차단 관계라면 참여할 수 없다.
+	memberIDs, err := databasegen.New(s.conn).FindUserIDsByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	for _, memberID := range memberIDs {
+		blocked, err := databasegen.New(s.conn).ExistsBlockBetween(ctx, databasegen.ExistsBlockBetweenParams{
+			UserID:      userData.ID,
+			OtherUserID: memberID,
 		})
 		if err != nil {
 			return nil, err
 		}
+		if blocked {
+			return nil, pnd.ErrUserBlocked(errors.New("cannot join a room with a blocked user"))
+		}
+	}
 
-		return chat.ToJoinRoom(row), nil
+	// 채팅방에 참여하지 않은 경우 일반 참여자로 참여
+	row, err := databasegen.New(s.conn).JoinRoom(ctx, databasegen.JoinRoomParams{
+		ID:     datatype.NewUUIDV7(),
+		RoomID: roomID,
+		UserID: userData.ID,
+		Role:   string(chat.MemberRole),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, pnd.ErrBadRequest(errors.New("user already joined in the room"))
+	return chat.ToJoinRoom(row), nil
 }
 
+// 채팅방을 나간다. 방장이 나가면 다음 방장을 지정하고, 남은 참여자가 없으면 채팅방을 삭제한다.
 func (s *ChatService) LeaveRoom(ctx context.Context, roomID uuid.UUID, fbUID string) error {
 	userData, err := databasegen.New(s.conn).FindUser(ctx, databasegen.FindUserParams{
 		FbUid: utils.StrToNullStr(fbUID),
@@ -138,25 +290,218 @@ func (s *ChatService) LeaveRoom(ctx context.Context, roomID uuid.UUID, fbUID str
 		return err
 	}
 
-	err = databasegen.New(s.conn).LeaveRoom(ctx, databasegen.LeaveRoomParams{
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	role, err := findRoomMemberRole(ctx, q, roomID, userData.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := q.LeaveRoom(ctx, databasegen.LeaveRoomParams{
 		RoomID: roomID,
 		UserID: userData.ID,
-	})
-	if err != nil {
+	}); err != nil {
 		return err
 	}
-	exists, err := databasegen.New(s.conn).UserExistsInRoom(ctx, roomID)
+
+	exists, err := q.UserExistsInRoom(ctx, roomID)
 	if err != nil {
 		return err
 	}
 
-	if !exists {
-		err = databasegen.New(s.conn).DeleteRoom(ctx, roomID)
-		if err != nil {
+	switch {
+	case !exists:
+		if err := q.DeleteRoom(ctx, roomID); err != nil {
+			return err
+		}
+	case role == chat.OwnerRole:
+		if err := q.PromoteNextRoomOwner(ctx, roomID); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	return tx.Commit()
+}
+
+// 채팅방에 현재 참여중인 사용자의 프로필과 역할을 조회한다. 채팅방 참여자만 조회할 수 있다.
+func (s *ChatService) FindRoomMembers(
+	ctx context.Context, roomID, userID uuid.UUID,
+) (*chat.RoomMembersView, error) {
+	q := databasegen.New(s.conn)
+	if _, err := findRoomMemberRole(ctx, q, roomID, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := q.FindRoomMembers(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return chat.ToRoomMembersView(roomID, rows), nil
+}
+
+// 채팅방 이름과 이미지를 수정한다. 방장과 관리자만 수정할 수 있다.
+func (s *ChatService) UpdateRoom(
+	ctx context.Context, roomID, userID uuid.UUID, req *chat.UpdateRoomRequest,
+) (*chat.RoomSimpleInfo, error) {
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	role, err := findRoomMemberRole(ctx, q, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.CanManageRoom() {
+		return nil, pnd.ErrForbidden(errors.New("only the owner or admins can update the room"))
+	}
+	if req.RemoveImage && req.ImageID.Valid {
+		return nil, pnd.ErrInvalidBody(errors.New("imageId cannot be used with removeImage"))
+	}
+
+	// 다른 사용자가 업로드한 이미지는 채팅방 이미지로 사용할 수 없다.
+	if req.ImageID.Valid {
+		owned, err := q.CountMediasByUploader(ctx, databasegen.CountMediasByUploaderParams{
+			Ids:        []uuid.UUID{req.ImageID.UUID},
+			UploaderID: uuid.NullUUID{UUID: userID, Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if owned == 0 {
+			return nil, pnd.ErrMediaNotOwned(errors.New("media is not uploaded by the user"))
+		}
+	}
+
+	params := databasegen.UpdateRoomInfoParams{
+		SetImage: req.ImageID.Valid || req.RemoveImage,
+		ImageID:  req.ImageID,
+		ID:       roomID,
+	}
+	if req.RoomName != nil {
+		params.SetName, params.Name = true, *req.RoomName
+	}
+	if err := q.UpdateRoomInfo(ctx, params); err != nil {
+		return nil, err
+	}
+
+	row, err := q.FindRoomByIDAndUserID(ctx, databasegen.FindRoomByIDAndUserIDParams{
+		ID:     roomID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return chat.ToUserChatRoomView(row), nil
+}
+
+// 채팅방 참여자를 관리자로 지정하거나 해제한다. 방장만 변경할 수 있다.
+func (s *ChatService) UpdateMemberRole(
+	ctx context.Context, roomID, userID, targetUserID uuid.UUID, role chat.Role,
+) (*chat.RoomMembersView, error) {
+	if userID == targetUserID {
+		return nil, pnd.ErrBadRequest(errors.New("cannot change your own role"))
+	}
+	if role != chat.AdminRole && role != chat.MemberRole {
+		return nil, pnd.ErrBadRequest(errors.New("role must be admin or member"))
+	}
+
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	myRole, err := findRoomMemberRole(ctx, q, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if myRole != chat.OwnerRole {
+		return nil, pnd.ErrForbidden(errors.New("only the owner can change member roles"))
+	}
+
+	// 대상 사용자도 채팅방에 참여중이어야 한다.
+	if _, err := findRoomMemberRole(ctx, q, roomID, targetUserID); err != nil {
+		return nil, err
+	}
+
+	if err := q.UpdateRoomMemberRole(ctx, databasegen.UpdateRoomMemberRoleParams{
+		RoomID: roomID,
+		UserID: targetUserID,
+		Role:   string(role),
+	}); err != nil {
+		return nil, err
+	}
+
+	rows, err := q.FindRoomMembers(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return chat.ToRoomMembersView(roomID, rows), nil
+}
+
+// 채팅방을 삭제하고 모든 참여자를 내보낸다. 방장만 삭제할 수 있다.
+func (s *ChatService) DeleteRoom(ctx context.Context, roomID, userID uuid.UUID) error {
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	role, err := findRoomMemberRole(ctx, q, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if role != chat.OwnerRole {
+		return pnd.ErrForbidden(errors.New("only the owner can delete the room"))
+	}
+
+	if err := q.DeleteRoom(ctx, roomID); err != nil {
+		return err
+	}
+	if err := q.LeaveAllRoomMembers(ctx, roomID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// 채팅방에 참여중인 사용자의 역할을 조회한다. 참여중이 아니면 ErrNotRoomMember를 반환한다.
+func findRoomMemberRole(
+	ctx context.Context, q *databasegen.Queries, roomID, userID uuid.UUID,
+) (chat.Role, error) {
+	role, err := q.FindRoomMemberRole(ctx, databasegen.FindRoomMemberRoleParams{
+		RoomID: roomID,
+		UserID: userID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", pnd.ErrNotRoomMember(errors.New("not a member of the room"))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return chat.Role(role), nil
 }
 
 func (s *ChatService) FindAllByUserUID(
@@ -203,23 +548,354 @@ func (s *ChatService) FindChatRoomByUIDAndRoomID(
 	return chat.ToUserChatRoomView(row), nil
 }
 
+// 채팅방에 현재 참여중인 사용자 ID 목록을 조회한다.
+func (s *ChatService) FindRoomMemberIDs(
+	ctx context.Context, roomID uuid.UUID,
+) ([]uuid.UUID, error) {
+	memberIDs, err := databasegen.New(s.conn).FindUserIDsByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberIDs, nil
+}
+
+// 사용자와 같은 채팅방에 참여중인 다른 사용자 ID 목록을 조회한다.
+func (s *ChatService) FindRoomMateIDs(
+	ctx context.Context, userID uuid.UUID,
+) ([]uuid.UUID, error) {
+	mateIDs, err := databasegen.New(s.conn).FindRoomMateIDsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mateIDs, nil
+}
+
+// 주어진 사용자가 차단했거나 주어진 사용자를 차단한 사용자 ID 목록을 조회한다.
+func (s *ChatService) FindBlockRelatedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	userIDs, err := databasegen.New(s.conn).FindBlockRelatedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// 채팅 메시지를 저장한다. 미디어 메시지인 경우 첨부된 미디어를 메시지에 연결한다.
+// ReplyToID가 주어지면 같은 채팅방의 메시지에 대한 답장으로 저장한다.
+// 같은 ClientMessageID로 이미 저장된 메시지가 있으면 created를 false로 하여 기존 메시지를 반환한다.
+func (s *ChatService) WriteMessage(
+	ctx context.Context, req *chat.WriteMessageRequest,
+) (message *chat.Message, created bool, err error) {
+	if err := req.Validate(); err != nil {
+		return nil, false, err
+	}
+
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	blocked, err := q.ExistsBlockInDirectRoom(ctx, databasegen.ExistsBlockInDirectRoomParams{
+		UserID: req.UserID,
+		RoomID: req.RoomID,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if blocked {
+		return nil, false, pnd.ErrUserBlocked(errors.New("cannot send a message to a blocked user"))
+	}
+
+	if req.ReplyToID.Valid {
+		parent, err := q.FindMessageByID(ctx, req.ReplyToID.UUID)
+		if err != nil {
+			return nil, false, err
+		}
+		if parent.RoomID != req.RoomID {
+			return nil, false, pnd.ErrBadRequest(errors.New("reply target is not in the room"))
+		}
+	}
+
+	// 다른 사용자가 업로드한 미디어는 첨부할 수 없다.
+	if len(req.MediaIDs) > 0 {
+		owned, err := q.CountMediasByUploader(ctx, databasegen.CountMediasByUploaderParams{
+			Ids:        req.MediaIDs,
+			UploaderID: uuid.NullUUID{UUID: req.UserID, Valid: true},
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if owned != int64(len(req.MediaIDs)) {
+			return nil, false, pnd.ErrMediaNotOwned(errors.New("media is not uploaded by the sender"))
+		}
+	}
+
+	clientMessageID := utils.StrToNullStr(req.ClientMessageID)
+	row, err := q.WriteMessage(ctx, databasegen.WriteMessageParams{
+		ID:              datatype.NewUUIDV7(),
+		UserID:          req.UserID,
+		RoomID:          req.RoomID,
+		MessageType:     req.MessageType,
+		Content:         req.Content,
+		ReplyToID:       req.ReplyToID,
+		ClientMessageID: clientMessageID,
+	})
+	if errors.Is(err, sql.ErrNoRows) && clientMessageID.Valid {
+		// 이미 저장된 메시지를 재전송한 경우 기존 메시지를 그대로 반환한다.
+		existing, err := q.FindMessageByClientMessageID(ctx, databasegen.FindMessageByClientMessageIDParams{
+			UserID:          req.UserID,
+			ClientMessageID: clientMessageID,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if existing.RoomID != req.RoomID {
+			return nil, false, pnd.ErrConflict(errors.New("client message id is already used in another room"))
+		}
+		return chat.ToMessage(existing), false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, mediaID := range req.MediaIDs {
+		if err := q.LinkResourceMedia(ctx, databasegen.LinkResourceMediaParams{
+			ID:           datatype.NewUUIDV7(),
+			MediaID:      mediaID,
+			ResourceID:   row.ID,
+			ResourceType: utils.StrToNullStr(resourcemedia.ChatMessageResourceType.String()),
+		}); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+
+	return chat.ToMessageFromWriteRow(row), true, nil
+}
+
+// 채팅 메시지의 내용을 수정한다. 작성자만 작성 후 일정 시간 이내에 텍스트 메시지를 수정할 수 있다.
+func (s *ChatService) EditMessage(
+	ctx context.Context,
+	roomID, messageID, userID uuid.UUID,
+	content string,
+) (*chat.Message, error) {
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	message, err := s.findEditableMessage(ctx, q, roomID, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if message.MessageType != chat.PlainMessage {
+		return nil, pnd.ErrBadRequest(errors.New("only plain messages can be edited"))
+	}
+	if err := chat.ValidateMessageContent(content); err != nil {
+		return nil, err
+	}
+
+	row, err := q.UpdateMessageContent(ctx, databasegen.UpdateMessageContentParams{
+		ID:      messageID,
+		Content: content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return chat.ToMessageFromUpdateRow(row), nil
+}
+
+// 채팅 메시지를 삭제한다. 삭제된 메시지는 메시지 목록에서 내용 없이 조회된다.
+func (s *ChatService) DeleteMessage(
+	ctx context.Context,
+	roomID, messageID, userID uuid.UUID,
+) (*chat.Message, error) {
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	if _, err := s.findEditableMessage(ctx, q, roomID, messageID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := q.DeleteMessage(ctx, messageID); err != nil {
+		return nil, err
+	}
+
+	row, err := q.FindMessageByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return chat.ToMessage(row), nil
+}
+
+func (s *ChatService) findEditableMessage(
+	ctx context.Context,
+	q *databasegen.Queries,
+	roomID, messageID, userID uuid.UUID,
+) (*chat.Message, error) {
+	row, err := q.FindMessageByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	message := chat.ToMessage(row)
+	if message.RoomID != roomID || message.IsDeleted {
+		return nil, pnd.ErrNotFound(errors.New("message not found"))
+	}
+	if !message.IsEditableBy(userID, time.Now()) {
+		return nil, pnd.ErrForbidden(errors.New("message can no longer be modified"))
+	}
+
+	return message, nil
+}
+
+// 채팅방에서 마지막으로 읽은 메시지를 갱신한다. 이미 더 최신 메시지를 읽은 경우 기존 값을 유지한다.
+func (s *ChatService) MarkRoomAsRead(
+	ctx context.Context, roomID, userID, messageID uuid.UUID,
+) (*chat.ReadReceipt, error) {
+	row, err := databasegen.New(s.conn).UpdateLastReadMessage(ctx, databasegen.UpdateLastReadMessageParams{
+		MessageID: messageID,
+		RoomID:    roomID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chat.ToReadReceipt(row), nil
+}
+
 /**
  * 채팅방의 메시지를 조회한다. 채팅메시지는 최신순으로 DESC 정렬을 진행한다.
+ * 채팅방 참여자만 조회할 수 있으며, 조회하는 사용자와 서로 차단 관계인 사용자의 메시지는 제외한다.
+ * userID - 조회하는 사용자의 ID
  * prev - 이전 메시지의 ID
  * next - 다음 메시지의 ID
  */
 func (s *ChatService) FindChatRoomMessagesByRoomID(
-	ctx context.Context, roomID uuid.UUID, prev, next uuid.NullUUID, limit int64,
+	ctx context.Context, roomID, userID uuid.UUID, prev, next uuid.NullUUID, limit int64,
+) (*chat.MessageCursorView, error) {
+	if _, err := findRoomMemberRole(ctx, databasegen.New(s.conn), roomID, userID); err != nil {
+		return nil, err
+	}
+
+	view, err := s.findChatRoomMessagesByRoomID(ctx, roomID, userID, prev, next, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 미디어 메시지에 첨부된 미디어를 함께 조회한다.
+	mediaMessageIDs := view.MediaMessageIDs()
+	if len(mediaMessageIDs) == 0 {
+		return view, nil
+	}
+
+	mediaRows, err := databasegen.New(s.conn).
+		FindResourceMediaByResourceIDs(ctx, databasegen.FindResourceMediaByResourceIDsParams{
+			ResourceIds:  mediaMessageIDs,
+			ResourceType: utils.StrToNullStr(resourcemedia.ChatMessageResourceType.String()),
+		})
+	if err != nil {
+		return nil, err
+	}
+	view.AttachMedias(mediaRows)
+
+	return view, nil
+}
+
+// 채팅방 메시지 내용에서 검색어를 찾는다. 채팅방 참여자만 검색할 수 있으며, 최신 메시지부터 반환한다.
+// 검색하는 사용자와 서로 차단 관계인 사용자의 메시지는 제외한다.
+// prev가 주어지면 prev보다 이전 메시지를, next가 주어지면 next보다 이후 메시지를 검색한다.
+func (s *ChatService) SearchMessages(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	keyword string,
+	prev, next uuid.NullUUID,
+	limit int64,
+) (*chat.MessageSearchView, error) {
+	keyword, err := chat.NormalizeSearchKeyword(keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	q := databasegen.New(s.conn)
+	if _, err := findRoomMemberRole(ctx, q, roomID, userID); err != nil {
+		return nil, err
+	}
+
+	params := databasegen.SearchMessagesByRoomIDParams{
+		RoomID:   roomID,
+		Keyword:  utils.EscapeLikePattern(keyword),
+		Prev:     prev,
+		Next:     next,
+		ViewerID: userID,
+		Limit:    int32(limit),
+	}
+	rows, err := q.SearchMessagesByRoomID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return chat.ToMessageSearchView(rows, keyword, false, false), nil
+	}
+
+	// next만 주어진 경우 오래된 순으로 조회되므로 최신 순으로 뒤집는다.
+	if !prev.Valid && next.Valid {
+		slices.Reverse(rows)
+	}
+
+	// 조회된 결과 앞뒤로 검색 결과가 더 있는지 확인한다.
+	params.Limit = 1
+	params.Prev, params.Next = uuid.NullUUID{}, uuid.NullUUID{UUID: rows[0].ID, Valid: true}
+	newer, err := q.SearchMessagesByRoomID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	params.Prev, params.Next = uuid.NullUUID{UUID: rows[len(rows)-1].ID, Valid: true}, uuid.NullUUID{}
+	older, err := q.SearchMessagesByRoomID(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return chat.ToMessageSearchView(rows, keyword, len(newer) > 0, len(older) > 0), nil
+}
+
+func (s *ChatService) findChatRoomMessagesByRoomID(
+	ctx context.Context, roomID, userID uuid.UUID, prev, next uuid.NullUUID, limit int64,
 ) (*chat.MessageCursorView, error) {
 	// prev와 next에 따라 다른 쿼리를 실행
 	if prev.Valid && next.Valid {
 		// prev와 next 모두 존재하는 경우
 		rows, err := databasegen.New(s.conn).
 			FindBetweenMessagesByRoomID(ctx, databasegen.FindBetweenMessagesByRoomIDParams{
-				Prev:   prev,
-				Next:   next,
-				Limit:  int32(limit),
-				RoomID: roomID,
+				Prev:     prev,
+				Next:     next,
+				Limit:    int32(limit),
+				RoomID:   roomID,
+				ViewerID: userID,
 			})
 		if err != nil {
 			return nil, err
@@ -239,8 +915,9 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 
 		hasPrev, err := databasegen.New(s.conn).
 			HasPrevMessages(ctx, databasegen.HasPrevMessagesParams{
-				ID:     lastID,
-				RoomID: roomID,
+				ID:       lastID,
+				RoomID:   roomID,
+				ViewerID: userID,
 			})
 		if err != nil {
 			return nil, err
@@ -248,8 +925,9 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 
 		hasNext, err := databasegen.New(s.conn).
 			HasNextMessages(ctx, databasegen.HasNextMessagesParams{
-				ID:     firstID,
-				RoomID: roomID,
+				ID:       firstID,
+				RoomID:   roomID,
+				ViewerID: userID,
 			})
 		if err != nil {
 			return nil, err
@@ -262,9 +940,10 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 		// prev만 존재하는 경우
 		rows, err := databasegen.New(s.conn).
 			FindPrevMessageByRoomID(ctx, databasegen.FindPrevMessageByRoomIDParams{
-				Prev:   prev,
-				Limit:  int32(limit),
-				RoomID: roomID,
+				Prev:     prev,
+				Limit:    int32(limit),
+				RoomID:   roomID,
+				ViewerID: userID,
 			})
 		if err != nil {
 			return nil, err
@@ -277,13 +956,13 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 		firstID := rows[0].ID
 		lastID := rows[len(rows)-1].ID
 
-		hasPrev, hasPrevError := s.HasPrevMessages(ctx, roomID, lastID)
+		hasPrev, hasPrevError := s.HasPrevMessages(ctx, roomID, userID, lastID)
 
 		if hasPrevError != nil {
 			return nil, hasPrevError
 		}
 
-		hasNext, hasNextError := s.HasNextMessages(ctx, roomID, firstID)
+		hasNext, hasNextError := s.HasNextMessages(ctx, roomID, userID, firstID)
 		if hasNextError != nil {
 			return nil, hasNextError
 		}
@@ -295,9 +974,10 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 		// next만 존재하는 경우
 		rows, err := databasegen.New(s.conn).
 			FindNextMessageByRoomID(ctx, databasegen.FindNextMessageByRoomIDParams{
-				Next:   next,
-				Limit:  int32(limit),
-				RoomID: roomID,
+				Next:     next,
+				Limit:    int32(limit),
+				RoomID:   roomID,
+				ViewerID: userID,
 			})
 		if err != nil {
 			return nil, err
@@ -310,13 +990,13 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 		firstID := rows[0].ID
 		lastID := rows[len(rows)-1].ID
 
-		hasPrev, hasPrevError := s.HasPrevMessages(ctx, roomID, lastID)
+		hasPrev, hasPrevError := s.HasPrevMessages(ctx, roomID, userID, lastID)
 
 		if hasPrevError != nil {
 			return nil, hasPrevError
 		}
 
-		hasNext, hasNextError := s.HasNextMessages(ctx, roomID, firstID)
+		hasNext, hasNextError := s.HasNextMessages(ctx, roomID, userID, firstID)
 		if hasNextError != nil {
 			return nil, hasNextError
 		}
@@ -327,8 +1007,9 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 	// prev와 next가 모두 없는 경우 Size만큼 최신 메시지를 가져온다.
 	rows, err := databasegen.New(s.conn).
 		FindMessagesByRoomIDAndSize(ctx, databasegen.FindMessagesByRoomIDAndSizeParams{
-			Limit:  int32(limit),
-			RoomID: roomID,
+			Limit:    int32(limit),
+			RoomID:   roomID,
+			ViewerID: userID,
 		})
 	if err != nil {
 		return nil, err
@@ -341,13 +1022,13 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 	firstID := rows[0].ID
 	lastID := rows[len(rows)-1].ID
 
-	hasPrev, hasPrevError := s.HasPrevMessages(ctx, roomID, lastID)
+	hasPrev, hasPrevError := s.HasPrevMessages(ctx, roomID, userID, lastID)
 
 	if hasPrevError != nil {
 		return nil, hasPrevError
 	}
 
-	hasNext, hasNextError := s.HasNextMessages(ctx, roomID, firstID)
+	hasNext, hasNextError := s.HasNextMessages(ctx, roomID, userID, firstID)
 	if hasNextError != nil {
 		return nil, hasNextError
 	}
@@ -357,11 +1038,12 @@ func (s *ChatService) FindChatRoomMessagesByRoomID(
 
 // hasPrev 메시지가 있는지 확인
 func (s *ChatService) HasPrevMessages(
-	ctx context.Context, roomID, messageID uuid.UUID,
+	ctx context.Context, roomID, userID, messageID uuid.UUID,
 ) (bool, error) {
 	hasPrev, err := databasegen.New(s.conn).HasPrevMessages(ctx, databasegen.HasPrevMessagesParams{
-		ID:     messageID,
-		RoomID: roomID,
+		ID:       messageID,
+		RoomID:   roomID,
+		ViewerID: userID,
 	})
 	if err != nil {
 		return false, err
@@ -372,11 +1054,12 @@ func (s *ChatService) HasPrevMessages(
 
 // hasNext 메시지가 있는지 확인
 func (s *ChatService) HasNextMessages(
-	ctx context.Context, roomID, messageID uuid.UUID,
+	ctx context.Context, roomID, userID, messageID uuid.UUID,
 ) (bool, error) {
 	hasNext, err := databasegen.New(s.conn).HasNextMessages(ctx, databasegen.HasNextMessagesParams{
-		ID:     messageID,
-		RoomID: roomID,
+		ID:       messageID,
+		RoomID:   roomID,
+		ViewerID: userID,
 	})
 	if err != nil {
 		return false, err
@@ -35,13 +35,26 @@ type UploadFileView struct {
 
 func (s *MediaService) UploadMedia(
 	ctx context.Context, file io.ReadSeeker, mediaType media.Type, fileName string,
+) (*media.DetailView, error) {
+	return s.uploadMedia(ctx, uuid.NullUUID{}, file, mediaType, fileName)
+}
+
+// UploadUserMedia 업로드한 사용자를 함께 기록한다. 채팅 메시지에는 본인이 업로드한 미디어만 첨부할 수 있다.
+func (s *MediaService) UploadUserMedia(
+	ctx context.Context, uploaderID uuid.UUID, file io.ReadSeeker, mediaType media.Type, fileName string,
+) (*media.DetailView, error) {
+	return s.uploadMedia(ctx, uuid.NullUUID{UUID: uploaderID, Valid: true}, file, mediaType, fileName)
+}
+
+func (s *MediaService) uploadMedia(
+	ctx context.Context, uploaderID uuid.NullUUID, file io.ReadSeeker, mediaType media.Type, fileName string,
 ) (*media.DetailView, error) {
 	url, err := s.uploader.UploadFile(file, fileName)
 	if err != nil {
 		return nil, err
 	}
 
-	created, err := s.CreateMedia(ctx, mediaType, url)
+	created, err := s.createMedia(ctx, uploaderID, mediaType, url)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +64,12 @@ func (s *MediaService) UploadMedia(
 
 func (s *MediaService) CreateMedia(
 	ctx context.Context, mediaType media.Type, url string,
+) (*media.DetailView, error) {
+	return s.createMedia(ctx, uuid.NullUUID{}, mediaType, url)
+}
+
+func (s *MediaService) createMedia(
+	ctx context.Context, uploaderID uuid.NullUUID, mediaType media.Type, url string,
 ) (*media.DetailView, error) {
 	tx, err := s.conn.BeginTx(ctx)
 	defer tx.Rollback()
@@ -59,9 +78,10 @@ func (s *MediaService) CreateMedia(
 	}
 
 	created, err := databasegen.New(s.conn).CreateMedia(ctx, databasegen.CreateMediaParams{
-		ID:        datatype.NewUUIDV7(),
-		MediaType: mediaType.String(),
-		Url:       url,
+		ID:         datatype.NewUUIDV7(),
+		MediaType:  mediaType.String(),
+		Url:        url,
+		UploaderID: uploaderID,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/notification"
+	"github.com/pet-sitter/pets-next-door-api/internal/infra/database"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+	firebaseinfra "github.com/pet-sitter/pets-next-door-api/internal/infra/firebase"
+)
+
+// 푸시 알림 본문에 보여줄 메시지의 최대 길이
+const maxPushBodyLength = 100
+
+type NotificationService struct {
+	conn     *database.DB
+	notifier firebaseinfra.Notifier
+}
+
+func NewNotificationService(conn *database.DB, notifier firebaseinfra.Notifier) *NotificationService {
+	return &NotificationService{
+		conn:     conn,
+		notifier: notifier,
+	}
+}
+
+// 기기 토큰을 등록한다. 다른 사용자가 등록했던 토큰이면 현재 사용자의 토큰으로 옮긴다.
+func (s *NotificationService) RegisterDeviceToken(
+	ctx context.Context, userID uuid.UUID, req *notification.RegisterDeviceTokenRequest,
+) (*notification.DeviceTokenView, error) {
+	row, err := databasegen.New(s.conn).UpsertDeviceToken(ctx, req.ToDBParams(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return notification.ToDeviceTokenView(row), nil
+}
+
+func (s *NotificationService) DeleteDeviceToken(ctx context.Context, userID uuid.UUID, token string) error {
+	return databasegen.New(s.conn).DeleteDeviceToken(ctx, databasegen.DeleteDeviceTokenParams{
+		UserID: userID,
+		Token:  token,
+	})
+}
+
+// 사용자들이 등록한 모든 기기로 알림을 보낸다. 더 이상 사용할 수 없는 토큰은 삭제한다.
+func (s *NotificationService) NotifyUsers(
+	ctx context.Context, userIDs []uuid.UUID, message firebaseinfra.PushMessage,
+) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	q := databasegen.New(s.conn)
+	rows, err := q.FindDeviceTokensByUserIDs(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	tokens := make([]string, len(rows))
+	for i, row := range rows {
+		tokens[i] = row.Token
+	}
+
+	invalidTokens, err := s.notifier.Send(ctx, tokens, message)
+	if len(invalidTokens) > 0 {
+		if err := q.DeleteDeviceTokens(ctx, invalidTokens); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// 채팅방에 접속해 있지 않은 참여자에게 새 메시지 알림을 보낸다.
+func (s *NotificationService) NotifyChatMessage(
+	ctx context.Context, userIDs []uuid.UUID, message *chat.Message,
+) error {
+	return s.NotifyUsers(ctx, userIDs, firebaseinfra.PushMessage{
+		Title: "새 메시지가 도착했어요",
+		Body:  chatPushBody(message),
+		Data: map[string]string{
+			"type":      "chat_message",
+			"roomId":    message.RoomID.String(),
+			"messageId": message.ID.String(),
+			"senderId":  message.UserID.String(),
+		},
+	})
+}
+
+func chatPushBody(message *chat.Message) string {
+	if message.MessageType == chat.MediaMessage && message.Content == "" {
+		return "사진을 보냈어요"
+	}
+	if utf8.RuneCountInString(message.Content) <= maxPushBodyLength {
+		return message.Content
+	}
+	return string([]rune(message.Content)[:maxPushBodyLength]) + "…"
+}
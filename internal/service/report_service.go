@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/report"
+	"github.com/pet-sitter/pets-next-door-api/internal/infra/database"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type ReportService struct {
+	conn *database.DB
+}
+
+func NewReportService(conn *database.DB) *ReportService {
+	return &ReportService{
+		conn: conn,
+	}
+}
+
+// 사용자, 채팅 메시지, 돌봄급구 게시글을 신고한다. 신고 대상의 작성자를 함께 기록한다.
+func (s *ReportService) CreateReport(
+	ctx context.Context, reporterID uuid.UUID, req *report.CreateReportRequest,
+) (*report.DetailView, error) {
+	q := databasegen.New(s.conn)
+	reportedUserID, err := s.findReportedUserID(ctx, q, reporterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if reportedUserID == reporterID {
+		return nil, pnd.ErrBadRequest(errors.New("cannot report yourself"))
+	}
+
+	row, err := q.CreateReport(ctx, req.ToDBParams(reporterID, reportedUserID))
+	if err != nil {
+		return nil, err
+	}
+
+	return report.ToDetailView(row), nil
+}
+
+func (s *ReportService) findReportedUserID(
+	ctx context.Context, q *databasegen.Queries, reporterID uuid.UUID, req *report.CreateReportRequest,
+) (uuid.UUID, error) {
+	switch req.TargetType {
+	case report.TargetTypeUser:
+		found, err := q.FindUser(ctx, databasegen.FindUserParams{
+			ID: uuid.NullUUID{UUID: req.TargetID, Valid: true},
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return found.ID, nil
+	case report.TargetTypeChatMessage:
+		found, err := q.FindMessageByID(ctx, req.TargetID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		// 참여 중인 채팅방의 메시지만 신고할 수 있다.
+		isMember, err := q.ExistsUserInRoom(ctx, databasegen.ExistsUserInRoomParams{
+			RoomID: found.RoomID,
+			UserID: reporterID,
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if !isMember {
+			return uuid.Nil, pnd.ErrNotRoomMember(errors.New("cannot report a message in a room you are not in"))
+		}
+		return found.UserID, nil
+	case report.TargetTypeSOSPost:
+		found, err := q.FindSOSPostByID(ctx, uuid.NullUUID{UUID: req.TargetID, Valid: true})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return found.AuthorID, nil
+	default:
+		return uuid.Nil, pnd.ErrInvalidBody(errors.New("invalid report target type"))
+	}
+}
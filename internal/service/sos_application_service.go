@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/sosapplication"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/sospost"
+	"github.com/pet-sitter/pets-next-door-api/internal/infra/database"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+)
+
+type SOSApplicationService struct {
+	conn *database.DB
+}
+
+func NewSOSApplicationService(conn *database.DB) *SOSApplicationService {
+	return &SOSApplicationService{
+		conn: conn,
+	}
+}
+
+// 돌봄급구 게시글에 돌보미로 지원한다. 모집 중인 게시글에만 지원할 수 있다.
+func (s *SOSApplicationService) Apply(
+	ctx context.Context, sosPostID, applicantID uuid.UUID, req *sosapplication.ApplyRequest,
+) (*sosapplication.DetailView, error) {
+	q := databasegen.New(s.conn)
+	sosPost, err := q.FindSOSPostByID(ctx, uuid.NullUUID{UUID: sosPostID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	if sosPost.AuthorID == applicantID {
+		return nil, pnd.ErrBadRequest(errors.New("cannot apply to your own post"))
+	}
+	if sospost.Status(sosPost.Status) != sospost.StatusOpen {
+		return nil, pnd.ErrBadRequest(errors.New("post is not open for applications"))
+	}
+
+	blocked, err := q.ExistsBlockBetween(ctx, databasegen.ExistsBlockBetweenParams{
+		UserID:      applicantID,
+		OtherUserID: sosPost.AuthorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, pnd.ErrUserBlocked(errors.New("cannot apply to a post of a blocked user"))
+	}
+
+	row, err := q.CreateSOSPostApplication(ctx, req.ToDBParams(sosPostID, applicantID))
+	if err != nil {
+		return nil, err
+	}
+
+	return sosapplication.ToDetailView(row), nil
+}
+
+// 게시글의 지원자 목록을 조회한다. 게시글 작성자만 조회할 수 있다.
+func (s *SOSApplicationService) FindApplications(
+	ctx context.Context, sosPostID, userID uuid.UUID,
+) (*sosapplication.ListView, error) {
+	q := databasegen.New(s.conn)
+	if _, err := findOwnSOSPost(ctx, q, sosPostID, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := q.FindSOSPostApplicationsBySOSPostID(ctx, sosPostID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sosapplication.ToListView(sosPostID, rows), nil
+}
+
+// 지원을 수락한다. 나머지 대기 중인 지원은 거절되고, 게시글은 매칭 완료 상태가 되며,
+// 게시글 작성자와 돌보미 사이의 1:1 채팅방이 만들어진다.
+func (s *SOSApplicationService) AcceptApplication(
+	ctx context.Context, sosPostID, applicationID, userID uuid.UUID,
+) (*sosapplication.AcceptView, error) {
+	tx, err := s.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	sosPost, err := findOwnSOSPost(ctx, q, sosPostID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted, err := updateApplicationStatus(ctx, q, sosPostID, applicationID, sosapplication.StatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.RejectPendingSOSPostApplications(ctx, databasegen.RejectPendingSOSPostApplicationsParams{
+		SosPostID: sosPostID,
+		ID:        applicationID,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := updateSOSPostStatus(
+		ctx, q, sosPostID, sospost.Status(sosPost.Status), sospost.StatusMatched,
+	); err != nil {
+		return nil, err
+	}
+
+	room, err := findOrCreateDirectRoom(
+		ctx, q, userID, accepted.ApplicantID, uuid.NullUUID{UUID: sosPostID, Valid: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &sosapplication.AcceptView{
+		Application: sosapplication.ToDetailView(accepted),
+		ChatRoom:    room,
+	}, nil
+}
+
+// 지원을 거절한다. 게시글 작성자만 거절할 수 있다.
+func (s *SOSApplicationService) RejectApplication(
+	ctx context.Context, sosPostID, applicationID, userID uuid.UUID,
+) (*sosapplication.DetailView, error) {
+	q := databasegen.New(s.conn)
+	if _, err := findOwnSOSPost(ctx, q, sosPostID, userID); err != nil {
+		return nil, err
+	}
+
+	rejected, err := updateApplicationStatus(ctx, q, sosPostID, applicationID, sosapplication.StatusRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	return sosapplication.ToDetailView(rejected), nil
+}
+
+// 게시글을 조회하고, 작성자가 아니면 오류를 반환한다.
+func findOwnSOSPost(
+	ctx context.Context, q *databasegen.Queries, sosPostID, userID uuid.UUID,
+) (databasegen.FindSOSPostByIDRow, error) {
+	sosPost, err := q.FindSOSPostByID(ctx, uuid.NullUUID{UUID: sosPostID, Valid: true})
+	if err != nil {
+		return databasegen.FindSOSPostByIDRow{}, err
+	}
+	if sosPost.AuthorID != userID {
+		return databasegen.FindSOSPostByIDRow{}, pnd.ErrForbidden(errors.New("only the author can manage applications"))
+	}
+	return sosPost, nil
+}
+
+// 대기 중인 지원의 상태를 변경한다. 이미 처리된 지원이면 오류를 반환한다.
+func updateApplicationStatus(
+	ctx context.Context,
+	q *databasegen.Queries,
+	sosPostID, applicationID uuid.UUID,
+	status sosapplication.Status,
+) (databasegen.SosPostApplication, error) {
+	application, err := q.FindSOSPostApplicationByID(ctx, applicationID)
+	if err != nil {
+		return databasegen.SosPostApplication{}, err
+	}
+	if application.SosPostID != sosPostID {
+		return databasegen.SosPostApplication{}, pnd.ErrBadRequest(errors.New("application does not belong to the post"))
+	}
+
+	updated, err := q.UpdateSOSPostApplicationStatus(ctx, databasegen.UpdateSOSPostApplicationStatusParams{
+		Status: status.String(),
+		ID:     applicationID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return databasegen.SosPostApplication{}, pnd.ErrConflict(errors.New("application has already been processed"))
+		}
+		return databasegen.SosPostApplication{}, err
+	}
+	return updated, nil
+}
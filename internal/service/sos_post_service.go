@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
 	utils "github.com/pet-sitter/pets-next-door-api/internal/common"
 	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/pet"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/resourcemedia"
@@ -31,6 +35,10 @@ func NewSOSPostService(conn *database.DB) *SOSPostService {
 func (service *SOSPostService) WriteSOSPost(
 	ctx context.Context, fbUID string, request *sospost.WriteSOSPostRequest,
 ) (*sospost.DetailView, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	tx, err := service.conn.BeginTx(ctx)
 	if err != nil {
 		return nil, err
@@ -99,14 +107,20 @@ func (service *SOSPostService) createSOSPost(
 	request *sospost.WriteSOSPostRequest, thumbnailID uuid.NullUUID,
 ) (databasegen.WriteSOSPostRow, error) {
 	params := databasegen.WriteSOSPostParams{
-		ID:          datatype.NewUUIDV7(),
-		AuthorID:    authorID,
-		Title:       utils.StrToNullStr(request.Title),
-		Content:     utils.StrToNullStr(request.Content),
-		Reward:      utils.StrToNullStr(request.Reward),
-		CareType:    utils.StrToNullStr(string(request.CareType)),
-		CarerGender: utils.StrToNullStr(request.CarerGender.String()),
-		RewardType:  utils.StrToNullStr(request.RewardType.String()),
+		ID:                  datatype.NewUUIDV7(),
+		AuthorID:            authorID,
+		Title:               utils.StrToNullStr(request.Title),
+		Content:             utils.StrToNullStr(request.Content),
+		Reward:              utils.StrToNullStr(request.Reward),
+		CareType:            utils.StrToNullStr(string(request.CareType)),
+		CarerGender:         utils.StrToNullStr(request.CarerGender.String()),
+		RewardType:          utils.StrToNullStr(request.RewardType.String()),
+		Region:              utils.StrPtrToNullStr(request.Region),
+		Latitude:            request.Location.NullLatitude(),
+		Longitude:           request.Location.NullLongitude(),
+		RewardAmount:        request.RewardDetail.NullAmount(),
+		RewardUnit:          request.RewardDetail.NullUnit(),
+		GifticonDescription: request.RewardDetail.NullGifticonDescription(),
 	}
 
 	if thumbnailID.Valid {
@@ -139,8 +153,10 @@ func (service *SOSPostService) saveAllLinks(
 	return service.SaveLinkPets(ctx, q, request.PetIDs, sosPostID)
 }
 
+// 조회하는 사용자가 주어지면 해당 사용자가 차단한 사용자의 게시글은 제외한다.
+// 기준 좌표가 없으면 조회하는 사용자의 위치를 기준 좌표로 사용한다.
 func (service *SOSPostService) FindSOSPosts(
-	ctx context.Context, page, size int, sortBy, filterType string,
+	ctx context.Context, params sospost.FindSOSPostsParams,
 ) (*sospost.FindSOSPostListView, error) {
 	tx, err := service.conn.BeginTx(ctx)
 	if err != nil {
@@ -148,47 +164,86 @@ func (service *SOSPostService) FindSOSPosts(
 	}
 	defer tx.Rollback()
 
-	sosPosts, err := databasegen.New(tx).FindSOSPosts(ctx, databasegen.FindSOSPostsParams{
+	q := databasegen.New(tx)
+	if params.Origin == nil && params.ViewerID.Valid {
+		viewer, err := q.FindUser(ctx, databasegen.FindUserParams{ID: params.ViewerID})
+		if err != nil {
+			return nil, err
+		}
+		params.Origin = commonvo.NewLocation(viewer.Latitude, viewer.Longitude)
+	}
+	if params.Origin == nil && (params.RadiusKm != nil || params.SortBy == sospost.SortByNearest) {
+		return nil, pnd.ErrInvalidQuery(errors.New("latitude and longitude are required to search by distance"))
+	}
+	if params.Keyword == nil && params.SortBy == sospost.SortByRelevance {
+		return nil, pnd.ErrInvalidQuery(errors.New("q is required to sort by relevance"))
+	}
+	var keyword sql.NullString
+	if params.Keyword != nil {
+		keyword = utils.StrToNullStr(utils.EscapeLikePattern(*params.Keyword))
+	}
+	// 커서로 조회하면 페이지 번호는 무시한다.
+	offset := (params.Page - 1) * params.Size
+	if params.Cursor != nil {
+		if params.Cursor.SortBy != params.SortBy {
+			return nil, pnd.ErrInvalidQuery(errors.New("cursor does not match sort_by"))
+		}
+		offset = 0
+	}
+	cursorID, cursorCreatedAt, cursorStartAt := params.Cursor.NullValues()
+
+	sosPosts, err := q.FindSOSPosts(ctx, databasegen.FindSOSPostsParams{
 		EarliestDateStartAt: utils.FormatDateString(time.Now().String()),
-		PetType:             utils.StrToNullStr(filterType),
-		SortBy:              utils.StrToNullStr(sortBy),
-		Limit:               utils.IntToNullInt32(size + 1),
-		Offset:              utils.IntToNullInt32((page - 1) * size),
+		IncludePast:         params.IncludePast,
+		PetType:             utils.StrToNullStr(params.FilterType),
+		ViewerID:            params.ViewerID,
+		Status:              utils.StrToNullStr(string(params.Status)),
+		Region:              utils.StrPtrToNullStr(params.Region),
+		RadiusKm:            utils.FloatPtrToNullFloat64(params.RadiusKm),
+		Latitude:            params.Origin.NullLatitude(),
+		Longitude:           params.Origin.NullLongitude(),
+		CareType:            utils.StrToNullStr(string(params.CareType)),
+		RewardType:          utils.StrToNullStr(string(params.RewardType)),
+		RewardUnit:          utils.StrToNullStr(string(params.RewardUnit)),
+		MinRewardAmount:     utils.IntPtrToNullInt32(params.MinRewardAmount),
+		MaxRewardAmount:     utils.IntPtrToNullInt32(params.MaxRewardAmount),
+		CarerGender:         utils.StrToNullStr(string(params.CarerGender)),
+		ConditionIds:        params.ConditionIDs,
+		DateFrom:            utils.TimePtrToNullTime(params.DateFrom),
+		DateTo:              utils.TimePtrToNullTime(params.DateTo),
+		TimeFrom:            utils.StrPtrToNullStr(params.TimeFrom),
+		TimeTo:              utils.StrPtrToNullStr(params.TimeTo),
+		Breed:               utils.StrPtrToNullStr(params.Breed),
+		Keyword:             keyword,
+		CursorID:            cursorID,
+		CursorCreatedAt:     cursorCreatedAt,
+		CursorStartAt:       cursorStartAt,
+		SortBy:              utils.StrToNullStr(params.SortBy),
+		Limit:               utils.IntToNullInt32(params.Size + 1),
+		Offset:              utils.IntToNullInt32(offset),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	sosPostInfoList := sospost.ToInfoListFromFindRow(sosPosts, page, size)
-	sosPostViews := sospost.FromEmptySOSPostInfoList(sosPostInfoList)
-
-	for _, sosPost := range sosPostInfoList.Items {
-		author, err := databasegen.New(tx).FindUser(ctx, databasegen.FindUserParams{
-			ID:             uuid.NullUUID{UUID: sosPost.AuthorID, Valid: true},
-			IncludeDeleted: true,
-		})
-		if err != nil {
-			return nil, err
+	sosPostInfoList := sospost.ToInfoListFromFindRow(sosPosts, params.Page, params.Size)
+	sosPostViews, err := toFindSOSPostListView(ctx, q, sosPostInfoList, params)
+	if err != nil {
+		return nil, err
+	}
+	if !sosPostInfoList.IsLastPage && len(sosPostInfoList.Items) > 0 {
+		lastPost := sosPostInfoList.Items[len(sosPostInfoList.Items)-1]
+		if cursor := sospost.NewCursor(params.SortBy, &lastPost); cursor != nil {
+			nextCursor := cursor.Encode()
+			sosPostViews.NextCursor = &nextCursor
 		}
-		sosPostView := sosPost.ToFindSOSPostInfoView(
-			&user.WithoutPrivateInfo{
-				ID:              author.ID,
-				Nickname:        author.Nickname,
-				ProfileImageURL: utils.NullStrToStrPtr(author.ProfileImageUrl),
-			},
-			media.ToListViewFromViewListForSOSPost(sosPost.Media),
-			soscondition.ToListViewFromViewForSOSPost(sosPost.Conditions),
-			sosPost.Pets.ToDetailViewList(),
-			sosPost.Dates.ToSOSDateViewList(),
-		)
-		sosPostViews.Items = append(sosPostViews.Items, *sosPostView)
 	}
 
 	return sosPostViews, nil
 }
 
 func (service *SOSPostService) FindSOSPostsByAuthorID(
-	ctx context.Context, authorID uuid.UUID, page, size int, sortBy, filterType string,
+	ctx context.Context, authorID uuid.UUID, params sospost.FindSOSPostsParams,
 ) (*sospost.FindSOSPostListView, error) {
 	tx, err := service.conn.BeginTx(ctx)
 	if err != nil {
@@ -196,48 +251,104 @@ func (service *SOSPostService) FindSOSPostsByAuthorID(
 	}
 	defer tx.Rollback()
 
-	sosPosts, err := databasegen.New(tx).
-		FindSOSPostsByAuthorID(ctx, databasegen.FindSOSPostsByAuthorIDParams{
-			EarliestDateStartAt: utils.FormatDateString(time.Now().String()),
-			PetType:             utils.StrToNullStr(filterType),
-			AuthorID:            uuid.NullUUID{UUID: authorID, Valid: true},
-			SortBy:              utils.StrToNullStr(sortBy),
-			Limit:               utils.IntToNullInt32(size + 1),
-			Offset:              utils.IntToNullInt32((page - 1) * size),
-		})
+	q := databasegen.New(tx)
+	sosPosts, err := q.FindSOSPostsByAuthorID(ctx, databasegen.FindSOSPostsByAuthorIDParams{
+		EarliestDateStartAt: utils.FormatDateString(time.Now().String()),
+		PetType:             utils.StrToNullStr(params.FilterType),
+		AuthorID:            uuid.NullUUID{UUID: authorID, Valid: true},
+		Status:              utils.StrToNullStr(string(params.Status)),
+		SortBy:              utils.StrToNullStr(params.SortBy),
+		Limit:               utils.IntToNullInt32(params.Size + 1),
+		Offset:              utils.IntToNullInt32((params.Page - 1) * params.Size),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	sosPostInfoList := sospost.ToInfoListFromFindAuthorIDRow(sosPosts, page, size)
-	sosPostViews := sospost.FromEmptySOSPostInfoList(sosPostInfoList)
+	sosPostInfoList := sospost.ToInfoListFromFindAuthorIDRow(sosPosts, params.Page, params.Size)
+	return toFindSOSPostListView(ctx, q, sosPostInfoList, params)
+}
 
+// toFindSOSPostListView 게시글 목록을 응답으로 변환한다.
+// 작성자 정보는 게시글 수와 관계없이 한 번의 쿼리로 조회한다.
+func toFindSOSPostListView(
+	ctx context.Context,
+	q *databasegen.Queries,
+	sosPostInfoList *sospost.SOSPostInfoList,
+	params sospost.FindSOSPostsParams,
+) (*sospost.FindSOSPostListView, error) {
+	authorIDs := make([]uuid.UUID, 0, len(sosPostInfoList.Items))
 	for _, sosPost := range sosPostInfoList.Items {
-		author, err := databasegen.New(tx).FindUser(ctx, databasegen.FindUserParams{
-			ID:             uuid.NullUUID{UUID: sosPost.AuthorID, Valid: true},
-			IncludeDeleted: true,
-		})
-		if err != nil {
+		authorIDs = append(authorIDs, sosPost.AuthorID)
+	}
+	authorRows, err := q.FindUsersByIDs(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+	authors := user.ToWithoutPrivateInfoMap(authorRows)
+
+	var bookmarked map[uuid.UUID]bool
+	if params.ViewerID.Valid {
+		if bookmarked, err = findBookmarkedSOSPostIDSet(ctx, q, params.ViewerID.UUID, sosPostInfoList); err != nil {
 			return nil, err
 		}
+	}
+
+	sosPostViews := sospost.FromEmptySOSPostInfoList(sosPostInfoList)
+	for _, sosPost := range sosPostInfoList.Items {
+		author, ok := authors[sosPost.AuthorID]
+		if !ok {
+			return nil, sql.ErrNoRows
+		}
 
 		sosPostView := sosPost.ToFindSOSPostInfoView(
-			&user.WithoutPrivateInfo{
-				ID:              author.ID,
-				Nickname:        author.Nickname,
-				ProfileImageURL: utils.NullStrToStrPtr(author.ProfileImageUrl),
-			},
+			author,
 			media.ToListViewFromViewListForSOSPost(sosPost.Media),
 			soscondition.ToListViewFromViewForSOSPost(sosPost.Conditions),
 			sosPost.Pets.ToDetailViewList(),
 			sosPost.Dates.ToSOSDateViewList(),
 		)
-
+		if params.Origin != nil && sosPost.Location != nil {
+			distanceKm := params.Origin.DistanceKm(sosPost.Location)
+			sosPostView.DistanceKm = &distanceKm
+		}
+		if params.Keyword != nil {
+			sosPostView.Highlight = sospost.NewHighlight(sosPost.Title, sosPost.Content, *params.Keyword)
+		}
+		if bookmarked != nil {
+			isBookmarked := bookmarked[sosPost.ID]
+			sosPostView.IsBookmarked = &isBookmarked
+		}
 		sosPostViews.Items = append(sosPostViews.Items, *sosPostView)
 	}
+
 	return sosPostViews, nil
 }
 
+// findBookmarkedSOSPostIDSet 목록의 게시글 중 사용자가 저장한 게시글의 ID를 조회한다.
+func findBookmarkedSOSPostIDSet(
+	ctx context.Context, q *databasegen.Queries, userID uuid.UUID, sosPostInfoList *sospost.SOSPostInfoList,
+) (map[uuid.UUID]bool, error) {
+	sosPostIDs := make([]uuid.UUID, 0, len(sosPostInfoList.Items))
+	for _, sosPost := range sosPostInfoList.Items {
+		sosPostIDs = append(sosPostIDs, sosPost.ID)
+	}
+
+	bookmarkedIDs, err := q.FindBookmarkedSOSPostIDs(ctx, databasegen.FindBookmarkedSOSPostIDsParams{
+		UserID:     userID,
+		SosPostIds: sosPostIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarked := make(map[uuid.UUID]bool, len(bookmarkedIDs))
+	for _, id := range bookmarkedIDs {
+		bookmarked[id] = true
+	}
+	return bookmarked, nil
+}
+
 func (service *SOSPostService) FindSOSPostByID(
 	ctx context.Context, id uuid.UUID,
 ) (*sospost.FindSOSPostView, error) {
@@ -281,6 +392,10 @@ func (service *SOSPostService) FindSOSPostByID(
 func (service *SOSPostService) UpdateSOSPost(
 	ctx context.Context, request *sospost.UpdateSOSPostRequest,
 ) (*sospost.DetailView, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	tx, err := service.conn.BeginTx(ctx)
 	if err != nil {
 		return nil, err
@@ -346,13 +461,19 @@ func (service *SOSPostService) updateSOSPost(
 	thumbnailID uuid.NullUUID,
 ) (databasegen.UpdateSOSPostRow, error) {
 	params := databasegen.UpdateSOSPostParams{
-		ID:          request.ID,
-		Title:       utils.StrToNullStr(request.Title),
-		Content:     utils.StrToNullStr(request.Content),
-		Reward:      utils.StrToNullStr(request.Reward),
-		CareType:    utils.StrToNullStr(string(request.CareType)),
-		CarerGender: utils.StrToNullStr(request.CarerGender.String()),
-		RewardType:  utils.StrToNullStr(request.RewardType.String()),
+		ID:                  request.ID,
+		Title:               utils.StrToNullStr(request.Title),
+		Content:             utils.StrToNullStr(request.Content),
+		Reward:              utils.StrToNullStr(request.Reward),
+		CareType:            utils.StrToNullStr(string(request.CareType)),
+		CarerGender:         utils.StrToNullStr(request.CarerGender.String()),
+		RewardType:          utils.StrToNullStr(request.RewardType.String()),
+		Region:              utils.StrPtrToNullStr(request.Region),
+		Latitude:            request.Location.NullLatitude(),
+		Longitude:           request.Location.NullLongitude(),
+		RewardAmount:        request.RewardDetail.NullAmount(),
+		RewardUnit:          request.RewardDetail.NullUnit(),
+		GifticonDescription: request.RewardDetail.NullGifticonDescription(),
 	}
 
 	if thumbnailID.Valid {
@@ -397,6 +518,123 @@ func (service *SOSPostService) updateAllLinks(
 	return service.SaveLinkPets(ctx, q, request.PetIDs, request.ID)
 }
 
+// 게시글 상태를 변경한다. open → matched → completed 순서로 진행되며, 완료되기 전에는 취소할 수 있다.
+func (service *SOSPostService) UpdateSOSPostStatus(
+	ctx context.Context, sosPostID uuid.UUID, status sospost.Status,
+) (*sospost.FindSOSPostView, error) {
+	tx, err := service.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	sosPost, err := q.FindSOSPostByID(ctx, uuid.NullUUID{UUID: sosPostID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := updateSOSPostStatus(ctx, q, sosPostID, sospost.Status(sosPost.Status), status); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return service.FindSOSPostByID(ctx, sosPostID)
+}
+
+// 돌봄급구 게시글을 삭제한다. 게시글에 연결된 날짜, 반려동물, 돌봄 조건, 이미지도 함께 삭제한다.
+func (service *SOSPostService) DeleteSOSPost(ctx context.Context, sosPostID uuid.UUID) error {
+	tx, err := service.conn.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	if err := q.DeleteSOSPost(ctx, sosPostID); err != nil {
+		return err
+	}
+	if err := service.DeleteLinkSOSPostDates(ctx, q, sosPostID); err != nil {
+		return err
+	}
+	if err := service.DeleteLinkSOSPostImages(ctx, q, sosPostID); err != nil {
+		return err
+	}
+	if err := service.DeleteLinkSOSPostConditions(ctx, q, sosPostID); err != nil {
+		return err
+	}
+	if err := service.DeleteLinkSOSPostPets(ctx, q, sosPostID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RecordSOSPostView 게시글 조회를 기록한다. 같은 조회자가 같은 날 다시 조회하면 조회수가 오르지 않는다.
+func (service *SOSPostService) RecordSOSPostView(ctx context.Context, sosPostID uuid.UUID, viewerKey string) error {
+	q := databasegen.New(service.conn)
+	if _, err := q.FindSOSPostByID(ctx, uuid.NullUUID{UUID: sosPostID, Valid: true}); err != nil {
+		return err
+	}
+
+	return q.IncreaseSOSPostViewCount(ctx, databasegen.IncreaseSOSPostViewCountParams{
+		SosPostID: sosPostID,
+		ViewerKey: viewerKey,
+	})
+}
+
+// BookmarkSOSPost 게시글을 저장한다. 이미 저장한 게시글이면 아무것도 하지 않는다.
+func (service *SOSPostService) BookmarkSOSPost(ctx context.Context, userID, sosPostID uuid.UUID) error {
+	q := databasegen.New(service.conn)
+	if _, err := q.FindSOSPostByID(ctx, uuid.NullUUID{UUID: sosPostID, Valid: true}); err != nil {
+		return err
+	}
+
+	return q.BookmarkSOSPost(ctx, databasegen.BookmarkSOSPostParams{
+		ID:        datatype.NewUUIDV7(),
+		UserID:    userID,
+		SosPostID: sosPostID,
+	})
+}
+
+func (service *SOSPostService) UnbookmarkSOSPost(ctx context.Context, userID, sosPostID uuid.UUID) error {
+	return databasegen.New(service.conn).UnbookmarkSOSPost(ctx, databasegen.UnbookmarkSOSPostParams{
+		UserID:    userID,
+		SosPostID: sosPostID,
+	})
+}
+
+// FindBookmarkedSOSPosts 사용자가 저장한 게시글을 최근에 저장한 순으로 조회한다.
+func (service *SOSPostService) FindBookmarkedSOSPosts(
+	ctx context.Context, userID uuid.UUID, page, size int,
+) (*sospost.FindSOSPostListView, error) {
+	tx, err := service.conn.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	q := databasegen.New(tx)
+	sosPosts, err := q.FindBookmarkedSOSPosts(ctx, databasegen.FindBookmarkedSOSPostsParams{
+		UserID: userID,
+		Limit:  int32(size + 1),
+		Offset: int32((page - 1) * size),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sosPostInfoList := sospost.ToInfoListFromFindBookmarkedRow(sosPosts, page, size)
+	return toFindSOSPostListView(ctx, q, sosPostInfoList, sospost.FindSOSPostsParams{
+		ViewerID: uuid.NullUUID{UUID: userID, Valid: true},
+		Page:     page,
+		Size:     size,
+	})
+}
+
 func (service *SOSPostService) CheckUpdatePermission(
 	ctx context.Context, fbUID string, sosPostID uuid.UUID,
 ) (bool, error) {
@@ -431,6 +669,7 @@ func (service *SOSPostService) SaveSOSDates(
 	ctx context.Context, tx *databasegen.Queries, dates []sospost.SOSDateView, sosPostID uuid.UUID,
 ) error {
 	for _, date := range dates {
+		date = date.WithDefaults()
 		dateStartAt, err := utils.StrToNullTime(date.DateStartAt)
 		if err != nil {
 			return err
@@ -439,11 +678,18 @@ func (service *SOSPostService) SaveSOSDates(
 		if err != nil {
 			return err
 		}
+		startAt, err := date.StartAt()
+		if err != nil {
+			return err
+		}
 
 		d, err := databasegen.New(service.conn).InsertSOSDate(ctx, databasegen.InsertSOSDateParams{
 			ID:          datatype.NewUUIDV7(),
 			DateStartAt: dateStartAt,
 			DateEndAt:   dateEndAt,
+			TimeSlots:   date.TimeSlotsJSON(),
+			Timezone:    date.Timezone,
+			StartAt:     sql.NullTime{Time: startAt, Valid: true},
 		})
 		if err != nil {
 			return err
@@ -464,8 +710,6 @@ func (service *SOSPostService) SaveLinkSOSPostImage(
 	ctx context.Context, tx *databasegen.Queries, imageIDs []uuid.UUID, sosPostID uuid.UUID,
 ) error {
 	for _, mediaID := range imageIDs {
-		log.Default().Println("mediaID", mediaID)
-
 		if err := tx.LinkResourceMedia(ctx, databasegen.LinkResourceMediaParams{
 			ID:           datatype.NewUUIDV7(),
 			MediaID:      mediaID,
@@ -482,8 +726,6 @@ func (service *SOSPostService) SaveLinkConditions(
 	ctx context.Context, tx *databasegen.Queries, conditionIDs []uuid.UUID, sosPostID uuid.UUID,
 ) error {
 	for _, conditionID := range conditionIDs {
-		log.Default().Println("conditionID", conditionID)
-
 		if err := tx.LinkSOSPostCondition(ctx, databasegen.LinkSOSPostConditionParams{
 			ID:             datatype.NewUUIDV7(),
 			SosPostID:      sosPostID,
@@ -499,8 +741,6 @@ func (service *SOSPostService) SaveLinkPets(
 	ctx context.Context, tx *databasegen.Queries, petIDs []uuid.UUID, sosPostID uuid.UUID,
 ) error {
 	for _, petID := range petIDs {
-		log.Default().Println("petID", petID)
-
 		if err := tx.LinkSOSPostPet(ctx, databasegen.LinkSOSPostPetParams{
 			ID:        datatype.NewUUIDV7(),
 			SosPostID: sosPostID,
@@ -536,6 +776,28 @@ func (service *SOSPostService) DeleteLinkSOSPostPets(
 	return tx.DeleteSOSPostPetBySOSPostID(ctx, sosPostID)
 }
 
+// 게시글 상태를 from에서 to로 변경한다.
+// 변경할 수 없는 상태이거나, 다른 요청이 먼저 상태를 바꾼 경우에는 오류를 반환한다.
+func updateSOSPostStatus(
+	ctx context.Context, q *databasegen.Queries, sosPostID uuid.UUID, from, to sospost.Status,
+) error {
+	if !from.CanTransitionTo(to) {
+		return pnd.ErrBadRequest(fmt.Errorf("cannot change post status from %s to %s", from, to))
+	}
+
+	if _, err := q.UpdateSOSPostStatus(ctx, databasegen.UpdateSOSPostStatusParams{
+		Status:        string(to),
+		ID:            sosPostID,
+		CurrentStatus: string(from),
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pnd.ErrConflict(errors.New("post status has already been changed"))
+		}
+		return err
+	}
+	return nil
+}
+
 func setThumbnailID(imageIDs []uuid.UUID) uuid.NullUUID {
 	if len(imageIDs) > 0 {
 		return uuid.NullUUID{UUID: imageIDs[0], Valid: true}
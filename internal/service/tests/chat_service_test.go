@@ -0,0 +1,567 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
+	"github.com/pet-sitter/pets-next-door-api/internal/tests"
+	"github.com/pet-sitter/pets-next-door-api/internal/tests/asserts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteMessage(t *testing.T) {
+	t.Run("채팅 메시지를 저장하면 메시지 목록에서 조회된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+
+		// when
+		written, _, err := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID:      room.ID,
+			UserID:      sender.ID,
+			MessageType: chat.PlainMessage,
+			Content:     "hello",
+		})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		found, err := chatService.FindChatRoomMessagesByRoomID(
+			ctx, room.ID, sender.ID, uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		assert.Len(t, *found.Items, 1)
+		assert.Equal(t, written.ID, (*found.Items)[0].ID)
+		assert.Equal(t, "hello", (*found.Items)[0].Content)
+		assert.Equal(t, sender.ID, (*found.Items)[0].UserID)
+	})
+
+	t.Run("미디어 메시지는 첨부된 미디어와 함께 조회된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		mediaService := tests.NewMockMediaService(db)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		image, _ := mediaService.UploadUserMedia(ctx, sender.ID, nil, media.TypeImage, "chat_image.jpg")
+
+		// when
+		_, _, err := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID:      room.ID,
+			UserID:      sender.ID,
+			MessageType: chat.MediaMessage,
+			MediaIDs:    []uuid.UUID{image.ID},
+		})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		found, err := chatService.FindChatRoomMessagesByRoomID(
+			ctx, room.ID, sender.ID, uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		assert.Len(t, *found.Items, 1)
+		asserts.MediaEquals(t, media.ListView{image}, (*found.Items)[0].Medias)
+	})
+
+	t.Run("같은 클라이언트 메시지 ID로 재전송하면 기존 메시지를 반환한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		req := &chat.WriteMessageRequest{
+			RoomID:          room.ID,
+			UserID:          sender.ID,
+			ClientMessageID: "client-message-1",
+			MessageType:     chat.PlainMessage,
+			Content:         "hello",
+		}
+		written, _, _ := chatService.WriteMessage(ctx, req)
+
+		// when
+		retried, created, err := chatService.WriteMessage(ctx, req)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.False(t, created)
+		assert.Equal(t, written.ID, retried.ID)
+		found, _ := chatService.FindChatRoomMessagesByRoomID(
+			ctx, room.ID, sender.ID, uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+		assert.Len(t, *found.Items, 1)
+	})
+
+	t.Run("최대 길이를 넘는 메시지는 저장할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+
+		// when
+		_, _, err := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID:      room.ID,
+			UserID:      sender.ID,
+			MessageType: chat.PlainMessage,
+			Content:     strings.Repeat("가", chat.MaxMessageLength+1),
+		})
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeMessageTooLong, appErr.Code)
+	})
+
+	t.Run("다른 사용자가 업로드한 미디어는 첨부할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		mediaService := tests.NewMockMediaService(db)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		other, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		image, _ := mediaService.UploadUserMedia(ctx, other.ID, nil, media.TypeImage, "chat_image.jpg")
+
+		// when
+		_, _, err := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID:      room.ID,
+			UserID:      sender.ID,
+			MessageType: chat.MediaMessage,
+			MediaIDs:    []uuid.UUID{image.ID},
+		})
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeMediaNotOwned, appErr.Code)
+	})
+}
+
+func TestFindChatRoomMessages(t *testing.T) {
+	t.Run("채팅방에 참여하지 않은 사용자는 메시지를 조회할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		other, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		_, _, _ = chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: sender.ID, MessageType: chat.PlainMessage, Content: "hello",
+		})
+
+		// when
+		_, err := chatService.FindChatRoomMessagesByRoomID(
+			ctx, room.ID, other.ID, uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeNotRoomMember, appErr.Code)
+	})
+}
+
+func TestMarkRoomAsRead(t *testing.T) {
+	t.Run("읽지 않은 메시지 수와 마지막 메시지를 함께 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		owner, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		other, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, owner.FirebaseUID)
+		first, _, _ := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: other.ID, MessageType: chat.PlainMessage, Content: "first",
+		})
+		chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: other.ID, MessageType: chat.PlainMessage, Content: "second",
+		})
+
+		// when
+		receipt, err := chatService.MarkRoomAsRead(ctx, room.ID, owner.ID, first.ID)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Equal(t, first.ID, receipt.LastReadMessageID)
+		found, _ := chatService.FindAllByUserUID(ctx, owner.FirebaseUID)
+		assert.Len(t, found.Items, 1)
+		assert.Equal(t, int64(1), found.Items[0].UnreadCount)
+		assert.Equal(t, "second", found.Items[0].LastMessage.Content)
+	})
+}
+
+func TestFindOrCreateDirectRoom(t *testing.T) {
+	t.Run("두 사용자 사이의 1:1 채팅방은 하나만 생성된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		owner, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		sitter, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+
+		// when
+		created, err := chatService.FindOrCreateDirectRoom(ctx, owner.ID, sitter.ID, uuid.NullUUID{})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		again, err := chatService.FindOrCreateDirectRoom(ctx, sitter.ID, owner.ID, uuid.NullUUID{})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Equal(t, created.ID, again.ID)
+		assert.Equal(t, chat.DirectRoomType, created.RoomType)
+
+		found, err := chatService.FindDirectRoom(ctx, sitter.ID, owner.ID)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		assert.Equal(t, created.ID, found.ID)
+
+		memberIDs, _ := chatService.FindRoomMemberIDs(ctx, created.ID)
+		assert.ElementsMatch(t, []uuid.UUID{owner.ID, sitter.ID}, memberIDs)
+	})
+
+	t.Run("자기 자신과는 1:1 채팅방을 만들 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		owner, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+
+		// when
+		_, err := chatService.FindOrCreateDirectRoom(ctx, owner.ID, owner.ID, uuid.NullUUID{})
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("다른 사용자는 1:1 채팅방에 참여할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		owner, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		sitter, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		other, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.FindOrCreateDirectRoom(ctx, owner.ID, sitter.ID, uuid.NullUUID{})
+
+		// when
+		_, err := chatService.JoinRoom(ctx, room.ID, other.FirebaseUID)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeBadRequest, appErr.Code)
+
+		memberIDs, _ := chatService.FindRoomMemberIDs(ctx, room.ID)
+		assert.ElementsMatch(t, []uuid.UUID{owner.ID, sitter.ID}, memberIDs)
+	})
+}
+
+func TestEditAndDeleteMessage(t *testing.T) {
+	t.Run("작성자는 메시지를 수정하고 답장할 수 있다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		parent, _, _ := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: sender.ID, MessageType: chat.PlainMessage, Content: "hello",
+		})
+
+		// when
+		edited, err := chatService.EditMessage(ctx, room.ID, parent.ID, sender.ID, "hello, edited")
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		reply, _, err := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID:      room.ID,
+			UserID:      sender.ID,
+			MessageType: chat.PlainMessage,
+			Content:     "reply",
+			ReplyToID:   uuid.NullUUID{UUID: parent.ID, Valid: true},
+		})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Equal(t, "hello, edited", edited.Content)
+		assert.Equal(t, parent.ID, *reply.ReplyToID)
+	})
+
+	t.Run("삭제된 메시지는 내용 없이 조회된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		written, _, _ := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: sender.ID, MessageType: chat.PlainMessage, Content: "secret",
+		})
+
+		// when
+		_, err := chatService.DeleteMessage(ctx, room.ID, written.ID, sender.ID)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		found, _ := chatService.FindChatRoomMessagesByRoomID(
+			ctx, room.ID, sender.ID, uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+		assert.Len(t, *found.Items, 1)
+		assert.True(t, (*found.Items)[0].IsDeleted)
+		assert.Empty(t, (*found.Items)[0].Content)
+	})
+
+	t.Run("작성자가 아니면 메시지를 수정할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		other, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		written, _, _ := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: sender.ID, MessageType: chat.PlainMessage, Content: "hello",
+		})
+
+		// when
+		_, err := chatService.EditMessage(ctx, room.ID, written.ID, other.ID, "hacked")
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+func TestRoomMembers(t *testing.T) {
+	t.Run("참여자 목록에 역할이 함께 조회되고, 방장이 나가면 다음 참여자가 방장이 된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		owner, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		member, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, owner.FirebaseUID)
+		if _, err := chatService.JoinRoom(ctx, room.ID, member.FirebaseUID); err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		found, err := chatService.FindRoomMembers(ctx, room.ID, member.ID)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		assert.Len(t, found.Items, 2)
+		assert.Equal(t, chat.OwnerRole, found.Items[0].Role)
+		assert.Equal(t, chat.MemberRole, found.Items[1].Role)
+
+		// when
+		if err := chatService.LeaveRoom(ctx, room.ID, owner.FirebaseUID); err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		found, _ = chatService.FindRoomMembers(ctx, room.ID, member.ID)
+		assert.Len(t, found.Items, 1)
+		assert.Equal(t, member.ID, found.Items[0].UserID)
+		assert.Equal(t, chat.OwnerRole, found.Items[0].Role)
+	})
+
+	t.Run("방장만 채팅방을 삭제할 수 있다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		owner, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		member, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, owner.FirebaseUID)
+		_, _ = chatService.JoinRoom(ctx, room.ID, member.FirebaseUID)
+
+		// when
+		err := chatService.DeleteRoom(ctx, room.ID, member.ID)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeForbidden, appErr.Code)
+
+		if err := chatService.DeleteRoom(ctx, room.ID, owner.ID); err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		_, err = chatService.FindRoomMembers(ctx, room.ID, member.ID)
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeNotRoomMember, appErr.Code)
+	})
+
+	t.Run("채팅방 이미지를 지울 수 있다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		mediaService := tests.NewMockMediaService(db)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		owner, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, owner.FirebaseUID)
+		image, _ := mediaService.UploadUserMedia(ctx, owner.ID, nil, media.TypeImage, "room_image.jpg")
+		updated, err := chatService.UpdateRoom(ctx, room.ID, owner.ID, &chat.UpdateRoomRequest{
+			ImageID: uuid.NullUUID{UUID: image.ID, Valid: true},
+		})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		assert.NotNil(t, updated.ImageURL)
+
+		// when
+		removed, err := chatService.UpdateRoom(ctx, room.ID, owner.ID, &chat.UpdateRoomRequest{RemoveImage: true})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Nil(t, removed.ImageURL)
+		assert.Equal(t, "room", removed.RoomName)
+	})
+}
+
+func TestSearchMessages(t *testing.T) {
+	t.Run("검색어가 포함된 메시지를 최신 순으로 검색한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+		contents := []string{"공동현관 비밀번호는 1234입니다", "고양이 사료는 베란다에 있어요", "비밀번호 바뀌었어요"}
+		written := make([]*chat.Message, len(contents))
+		for i, content := range contents {
+			written[i], _, _ = chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+				RoomID: room.ID, UserID: sender.ID, MessageType: chat.PlainMessage, Content: content,
+			})
+		}
+
+		// when
+		found, err := chatService.SearchMessages(
+			ctx, room.ID, sender.ID, "비밀번호", uuid.NullUUID{}, uuid.NullUUID{}, 1,
+		)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Len(t, found.Items, 1)
+		assert.Equal(t, written[2].ID, found.Items[0].ID)
+		assert.True(t, found.HasPrev)
+		assert.False(t, found.HasNext)
+
+		older, _ := chatService.SearchMessages(ctx, room.ID, sender.ID, "비밀번호", uuid.NullUUID{
+			UUID: *found.PrevID, Valid: true,
+		}, uuid.NullUUID{}, 1)
+		assert.Len(t, older.Items, 1)
+		assert.Equal(t, written[0].ID, older.Items[0].ID)
+		assert.Equal(t, contents[0], older.Items[0].Snippet)
+		assert.False(t, older.HasPrev)
+		assert.True(t, older.HasNext)
+	})
+
+	t.Run("채팅방에 참여하지 않은 사용자는 검색할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		other, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, sender.FirebaseUID)
+
+		// when
+		_, err := chatService.SearchMessages(
+			ctx, room.ID, other.ID, "비밀번호", uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeNotRoomMember, appErr.Code)
+	})
+}
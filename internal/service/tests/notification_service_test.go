@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/notification"
+	firebaseinfra "github.com/pet-sitter/pets-next-door-api/internal/infra/firebase"
+	"github.com/pet-sitter/pets-next-door-api/internal/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyChatMessage(t *testing.T) {
+	t.Run("등록된 기기로 채팅 메시지 알림을 보낸다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		notifier := firebaseinfra.NewInMemoryNotifier()
+		notificationService := tests.NewMockNotificationService(db, notifier)
+
+		// given
+		sender, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		receiver, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		notificationService.RegisterDeviceToken(ctx, receiver.ID, &notification.RegisterDeviceTokenRequest{
+			Token:    "receiver-token",
+			Platform: notification.PlatformIOS,
+		})
+		message := &chat.Message{
+			ID:          uuid.New(),
+			UserID:      sender.ID,
+			RoomID:      uuid.New(),
+			MessageType: chat.PlainMessage,
+			Content:     "hello",
+		}
+
+		// when
+		err := notificationService.NotifyChatMessage(ctx, []uuid.UUID{receiver.ID}, message)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		sent := notifier.Sent()
+		assert.Len(t, sent, 1)
+		assert.Equal(t, []string{"receiver-token"}, sent[0].Tokens)
+		assert.Equal(t, "hello", sent[0].Message.Body)
+		assert.Equal(t, message.RoomID.String(), sent[0].Message.Data["roomId"])
+	})
+
+	t.Run("유효하지 않은 기기 토큰은 삭제된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		notifier := firebaseinfra.NewInMemoryNotifier()
+		notificationService := tests.NewMockNotificationService(db, notifier)
+
+		// given
+		receiver, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		notificationService.RegisterDeviceToken(ctx, receiver.ID, &notification.RegisterDeviceTokenRequest{
+			Token:    "expired-token",
+			Platform: notification.PlatformAndroid,
+		})
+		notifier.InvalidTokens["expired-token"] = true
+		push := firebaseinfra.PushMessage{Title: "title", Body: "body"}
+		notificationService.NotifyUsers(ctx, []uuid.UUID{receiver.ID}, push)
+		delete(notifier.InvalidTokens, "expired-token")
+
+		// when
+		err := notificationService.NotifyUsers(ctx, []uuid.UUID{receiver.ID}, push)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Empty(t, notifier.Sent())
+	})
+}
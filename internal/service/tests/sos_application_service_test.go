@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/sosapplication"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/sospost"
+	"github.com/pet-sitter/pets-next-door-api/internal/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSOSApplications(t *testing.T) {
+	t.Run("지원을 수락하면 나머지 지원은 거절되고 1:1 채팅방이 만들어진다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		applicationService := tests.NewMockSOSApplicationService(db)
+
+		// given
+		sosPost, owner := writeDummySOSPost(ctx, t, db)
+		sitter, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		otherSitter, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		applied, _ := applicationService.Apply(
+			ctx, sosPost.ID, sitter.ID, &sosapplication.ApplyRequest{Message: "돌봐드릴게요"},
+		)
+		applicationService.Apply(ctx, sosPost.ID, otherSitter.ID, &sosapplication.ApplyRequest{Message: "저도요"})
+
+		// when
+		accepted, err := applicationService.AcceptApplication(ctx, sosPost.ID, applied.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, sosapplication.StatusAccepted, accepted.Application.Status)
+		assert.Equal(t, sosPost.ID, *accepted.ChatRoom.SOSPostID)
+
+		applications, _ := applicationService.FindApplications(ctx, sosPost.ID, owner.ID)
+		for _, application := range applications.Items {
+			if application.Applicant.ID == sitter.ID {
+				assert.Equal(t, sosapplication.StatusAccepted, application.Status)
+			} else {
+				assert.Equal(t, sosapplication.StatusRejected, application.Status)
+			}
+		}
+
+		found, _ := tests.NewMockSOSPostService(db).FindSOSPostByID(ctx, sosPost.ID)
+		assert.Equal(t, sospost.StatusMatched, found.Status)
+	})
+
+	t.Run("게시글 작성자가 아니면 지원자 목록을 조회할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		applicationService := tests.NewMockSOSApplicationService(db)
+
+		// given
+		sosPost, _ := writeDummySOSPost(ctx, t, db)
+		sitter, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		applicationService.Apply(ctx, sosPost.ID, sitter.ID, &sosapplication.ApplyRequest{Message: "돌봐드릴게요"})
+
+		// when
+		_, err := applicationService.FindApplications(ctx, sosPost.ID, sitter.ID)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeForbidden, appErr.Code)
+	})
+}
@@ -2,9 +2,12 @@ package service_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/commonvo"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/pet"
@@ -15,6 +18,7 @@ import (
 	"github.com/pet-sitter/pets-next-door-api/internal/tests"
 	"github.com/pet-sitter/pets-next-door-api/internal/tests/asserts"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setUp(ctx context.Context, t *testing.T) (*database.DB, func(t *testing.T)) {
@@ -98,6 +102,56 @@ func TestCreateSOSPost(t *testing.T) {
 		writtenAndFoundSOSPostEquals(t, *sosPostData, *found)
 		assert.Equal(t, owner.ID, created.AuthorID)
 	})
+
+	t.Run("기프티콘이 아닌 사례에 기프티콘 설명이 있으면 에러를 반환한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		request := tests.NewDummyWriteSOSPostRequest([]uuid.UUID{}, []uuid.UUID{}, 0, []uuid.UUID{})
+		gifticonDescription := "커피 기프티콘"
+		request.RewardDetail.GifticonDescription = &gifticonDescription
+
+		// when
+		_, err := sosPostService.WriteSOSPost(ctx, "", request)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeInvalidBody, appErr.Code)
+	})
+
+	t.Run("같은 날의 돌봄 시간대가 겹치면 에러를 반환한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		request := tests.NewDummyWriteSOSPostRequest([]uuid.UUID{}, []uuid.UUID{}, 0, []uuid.UUID{})
+		request.Dates = []sospost.SOSDateView{
+			{
+				DateStartAt: "2024-04-10",
+				DateEndAt:   "2024-04-20",
+				TimeSlots:   []sospost.TimeSlot{{StartTime: "08:00", EndTime: "09:00"}},
+			},
+			{
+				DateStartAt: "2024-04-15",
+				DateEndAt:   "2024-04-25",
+				TimeSlots:   []sospost.TimeSlot{{StartTime: "08:30", EndTime: "10:00"}},
+			},
+		}
+
+		// when
+		_, err := sosPostService.WriteSOSPost(ctx, "", request)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeInvalidBody, appErr.Code)
+	})
 }
 
 func TestFindSOSPosts(t *testing.T) {
@@ -155,7 +209,9 @@ func TestFindSOSPosts(t *testing.T) {
 		}
 
 		// when
-		foundList, err := sosPostService.FindSOSPosts(ctx, 1, 3, "newest", "all")
+		foundList, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page: 1, Size: 3, SortBy: "newest", FilterType: "all",
+		})
 		if err != nil {
 			t.Errorf("got %v want %v", err, nil)
 		}
@@ -235,7 +291,9 @@ func TestFindSOSPosts(t *testing.T) {
 		}
 
 		// when
-		sosPostList, _ := sosPostService.FindSOSPosts(ctx, 1, 3, "newest", "all")
+		sosPostList, _ := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page: 1, Size: 3, SortBy: "newest", FilterType: "all",
+		})
 
 		// then
 		for i, sosPost := range sosPostList.Items {
@@ -324,7 +382,9 @@ func TestFindSOSPosts(t *testing.T) {
 		writeRequests = append(writeRequests, *request)
 
 		// when
-		foundList, _ := sosPostService.FindSOSPosts(ctx, 1, 3, "newest", "all")
+		foundList, _ := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page: 1, Size: 3, SortBy: "newest", FilterType: "all",
+		})
 
 		// then
 		for i, sosPost := range foundList.Items {
@@ -393,7 +453,9 @@ func TestFindSOSPosts(t *testing.T) {
 		}
 
 		// when
-		foundList, _ := sosPostService.FindSOSPostsByAuthorID(ctx, owner.ID, 1, 3, "newest", "all")
+		foundList, _ := sosPostService.FindSOSPostsByAuthorID(ctx, owner.ID, sospost.FindSOSPostsParams{
+			Page: 1, Size: 3, SortBy: "newest", FilterType: "all",
+		})
 
 		// then
 		for i, sosPost := range foundList.Items {
@@ -406,6 +468,332 @@ func TestFindSOSPosts(t *testing.T) {
 			writtenAndFoundSOSPostEquals(t, writeRequests[idx], sosPost)
 		}
 	})
+
+	t.Run("여러 작성자의 게시글을 조회하면 게시글마다 작성자 정보를 채운다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		authors := make(map[uuid.UUID]*user.InternalView)
+		for range 3 {
+			_, author := writeDummySOSPost(ctx, t, db)
+			authors[author.ID] = author
+		}
+
+		// when
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page: 1, Size: 20, SortBy: sospost.SortByNewest, FilterType: "all", IncludePast: true,
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, found.Items, len(authors))
+		for _, item := range found.Items {
+			assert.Equal(t, authors[item.Author.ID].Nickname, item.Author.Nickname)
+		}
+	})
+}
+
+func TestFindSOSPostsByLocation(t *testing.T) {
+	t.Run("기준 좌표에서 반경 안에 있는 게시글을 가까운 순으로 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		locations := []commonvo.Location{
+			{Latitude: 37.4979, Longitude: 127.0276}, // 강남역
+			{Latitude: 37.5665, Longitude: 126.9780}, // 서울시청
+			{Latitude: 35.1796, Longitude: 129.0756}, // 부산시청
+		}
+		written := make([]*sospost.DetailView, len(locations))
+		for i, location := range locations {
+			written[i], _ = writeDummySOSPost(ctx, t, db, func(request *sospost.WriteSOSPostRequest) {
+				request.Location = &location
+				// 지난 날짜의 게시글은 목록에서 제외되므로 앞으로의 날짜로 작성한다.
+				request.Dates = []sospost.SOSDateView{{
+					DateStartAt: time.Now().AddDate(0, 0, i+1).Format(time.DateOnly),
+					DateEndAt:   time.Now().AddDate(0, 0, i+2).Format(time.DateOnly),
+				}}
+			})
+		}
+
+		// when
+		radiusKm := 20.0
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page:       1,
+			Size:       20,
+			SortBy:     sospost.SortByNearest,
+			FilterType: "all",
+			Origin:     &commonvo.Location{Latitude: 37.5651, Longitude: 126.9895}, // 을지로입구역
+			RadiusKm:   &radiusKm,
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, found.Items, 2)
+		assert.Equal(t, written[1].ID, found.Items[0].ID)
+		assert.Equal(t, written[0].ID, found.Items[1].ID)
+		assert.Less(t, *found.Items[0].DistanceKm, *found.Items[1].DistanceKm)
+	})
+
+	t.Run("기준 좌표 없이 가까운 순으로 조회할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// when
+		_, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page: 1, Size: 20, SortBy: sospost.SortByNearest, FilterType: "all",
+		})
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeInvalidQuery, appErr.Code)
+	})
+}
+
+func TestFindSOSPostsWithFilters(t *testing.T) {
+	t.Run("돌봄 유형과 돌봄 기간이 일치하는 게시글만 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		conditions, err := service.NewSOSConditionService(db).FindConditions(ctx)
+		require.NoError(t, err)
+		posts := []struct {
+			careType  sospost.CareType
+			startDays int
+		}{
+			{sospost.CareTypeFoster, 1},
+			{sospost.CareTypeVisiting, 1},
+			{sospost.CareTypeFoster, 30},
+		}
+		written := make([]*sospost.DetailView, len(posts))
+		for i, post := range posts {
+			written[i], _ = writeDummySOSPost(ctx, t, db, func(request *sospost.WriteSOSPostRequest) {
+				request.CareType = post.careType
+				request.Dates = []sospost.SOSDateView{{
+					DateStartAt: time.Now().AddDate(0, 0, post.startDays).Format(time.DateOnly),
+					DateEndAt:   time.Now().AddDate(0, 0, post.startDays+1).Format(time.DateOnly),
+				}}
+			})
+		}
+
+		// when
+		dateFrom := time.Now()
+		dateTo := time.Now().AddDate(0, 0, 7)
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page:         1,
+			Size:         20,
+			SortBy:       sospost.SortByNewest,
+			FilterType:   "all",
+			CareType:     sospost.CareTypeFoster,
+			ConditionIDs: []uuid.UUID{conditions[0].ID},
+			DateFrom:     &dateFrom,
+			DateTo:       &dateTo,
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, found.Items, 1)
+		assert.Equal(t, written[0].ID, found.Items[0].ID)
+	})
+
+	t.Run("지난 게시글 포함 여부에 따라 돌봄 시작일이 지난 게시글을 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		writeDummySOSPost(ctx, t, db)
+
+		// when
+		params := sospost.FindSOSPostsParams{
+			Page: 1, Size: 20, SortBy: sospost.SortByNewest, FilterType: "all",
+		}
+		withoutPast, err := sosPostService.FindSOSPosts(ctx, params)
+		assert.NoError(t, err)
+		params.IncludePast = true
+		withPast, err := sosPostService.FindSOSPosts(ctx, params)
+		assert.NoError(t, err)
+
+		// then
+		assert.Empty(t, withoutPast.Items)
+		assert.Len(t, withPast.Items, 1)
+	})
+
+	t.Run("사례 금액이 주어진 범위 안에 있는 게시글만 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		rewardAmounts := []int{10000, 30000, 50000}
+		written := make([]*sospost.DetailView, len(rewardAmounts))
+		for i := range rewardAmounts {
+			written[i], _ = writeDummySOSPost(ctx, t, db, func(request *sospost.WriteSOSPostRequest) {
+				request.RewardDetail.Amount = &rewardAmounts[i]
+			})
+		}
+
+		// when
+		minRewardAmount, maxRewardAmount := 20000, 40000
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page:            1,
+			Size:            20,
+			SortBy:          sospost.SortByNewest,
+			FilterType:      "all",
+			IncludePast:     true,
+			RewardUnit:      sospost.RewardUnitPerVisit,
+			MinRewardAmount: &minRewardAmount,
+			MaxRewardAmount: &maxRewardAmount,
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, found.Items, 1)
+		assert.Equal(t, written[1].ID, found.Items[0].ID)
+		assert.Equal(t, written[1].RewardDetail, found.Items[0].RewardDetail)
+	})
+}
+
+func TestFindSOSPostsByKeyword(t *testing.T) {
+	t.Run("검색어를 포함하는 게시글을 제목에서 일치하는 순으로 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		contents := []struct {
+			title   string
+			content string
+		}{
+			{"주말 산책 부탁드려요", "하루 두 번 Medication 챙겨주세요"},
+			{"medication 필요한 노령견", "하루 두 번 산책 부탁드려요"},
+			{"주말 산책 부탁드려요", "하루 두 번 산책 부탁드려요"},
+		}
+		written := make([]*sospost.DetailView, len(contents))
+		for i, content := range contents {
+			written[i], _ = writeDummySOSPost(ctx, t, db, func(request *sospost.WriteSOSPostRequest) {
+				request.Title = content.title
+				request.Content = content.content
+				request.Dates = []sospost.SOSDateView{{
+					DateStartAt: time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
+					DateEndAt:   time.Now().AddDate(0, 0, 2).Format(time.DateOnly),
+				}}
+			})
+		}
+
+		// when
+		keyword := "medication"
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page:       1,
+			Size:       20,
+			SortBy:     sospost.SortByRelevance,
+			FilterType: "all",
+			Keyword:    &keyword,
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, found.Items, 2)
+		assert.Equal(t, written[1].ID, found.Items[0].ID)
+		assert.Equal(t, written[0].ID, found.Items[1].ID)
+		assert.Contains(t, found.Items[1].Highlight.Content, sospost.HighlightSegment{Text: "Medication", Matched: true})
+	})
+}
+
+func TestFindSOSPostsByCursor(t *testing.T) {
+	for _, sortBy := range []string{sospost.SortByNewest, sospost.SortByDeadline} {
+		t.Run(sortBy+" 정렬에서 커서로 다음 게시글을 중복 없이 조회한다", func(t *testing.T) {
+			ctx := context.Background()
+			db, tearDown := setUp(ctx, t)
+			defer tearDown(t)
+			sosPostService := tests.NewMockSOSPostService(db)
+
+			// given
+			// 마감순 정렬에서 같은 시작일의 게시글도 커서로 구분되도록 두 게시글의 시작일을 같게 둔다.
+			for _, startDays := range []int{1, 1, 3} {
+				writeDummySOSPost(ctx, t, db, func(request *sospost.WriteSOSPostRequest) {
+					request.Dates = []sospost.SOSDateView{{
+						DateStartAt: time.Now().AddDate(0, 0, startDays).Format(time.DateOnly),
+						DateEndAt:   time.Now().AddDate(0, 0, startDays+1).Format(time.DateOnly),
+					}}
+				})
+			}
+
+			// when
+			params := sospost.FindSOSPostsParams{Page: 1, Size: 2, SortBy: sortBy, FilterType: "all"}
+			firstPage, err := sosPostService.FindSOSPosts(ctx, params)
+			assert.NoError(t, err)
+			params.Cursor, err = sospost.DecodeCursor(*firstPage.NextCursor)
+			assert.NoError(t, err)
+			secondPage, err := sosPostService.FindSOSPosts(ctx, params)
+			assert.NoError(t, err)
+
+			// then
+			assert.Len(t, firstPage.Items, 2)
+			assert.Len(t, secondPage.Items, 1)
+			assert.Nil(t, secondPage.NextCursor)
+			for _, item := range firstPage.Items {
+				assert.NotEqual(t, item.ID, secondPage.Items[0].ID)
+			}
+		})
+	}
+
+	t.Run("마감순 정렬에서 돌봄 날짜가 없는 게시글에서 페이지가 나뉘어도 다음 게시글을 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		written := make([]*sospost.DetailView, 3)
+		for i := range written {
+			written[i], _ = writeDummySOSPost(ctx, t, db, func(request *sospost.WriteSOSPostRequest) {
+				request.Dates = []sospost.SOSDateView{{
+					DateStartAt: time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
+					DateEndAt:   time.Now().AddDate(0, 0, 2).Format(time.DateOnly),
+				}}
+			})
+		}
+		// 돌봄 날짜가 없는 게시글은 마감순 정렬에서 마지막에 온다.
+		for _, dateless := range written[1:] {
+			_, err := db.DB.ExecContext(ctx, "DELETE FROM sos_posts_dates WHERE sos_post_id = $1", dateless.ID)
+			require.NoError(t, err)
+		}
+
+		// when
+		params := sospost.FindSOSPostsParams{
+			Page: 1, Size: 2, SortBy: sospost.SortByDeadline, FilterType: "all", IncludePast: true,
+		}
+		firstPage, err := sosPostService.FindSOSPosts(ctx, params)
+		assert.NoError(t, err)
+		params.Cursor, err = sospost.DecodeCursor(*firstPage.NextCursor)
+		assert.NoError(t, err)
+		secondPage, err := sosPostService.FindSOSPosts(ctx, params)
+		assert.NoError(t, err)
+
+		// then
+		assert.Len(t, firstPage.Items, 2)
+		assert.Equal(t, written[0].ID, firstPage.Items[0].ID)
+		assert.Equal(t, written[2].ID, firstPage.Items[1].ID)
+		assert.Nil(t, params.Cursor.StartAt)
+		assert.Len(t, secondPage.Items, 1)
+		assert.Equal(t, written[1].ID, secondPage.Items[0].ID)
+		assert.Nil(t, secondPage.NextCursor)
+	})
 }
 
 func TestFindSOSPostByID(t *testing.T) {
@@ -526,6 +914,8 @@ func TestUpdateSOSPost(t *testing.T) {
 		)
 
 		// when
+		rewardAmount := 20000
+		rewardUnit := sospost.RewardUnitPerDay
 		updateRequest := &sospost.UpdateSOSPostRequest{
 			ID:       sosPost.ID,
 			Title:    "Title2",
@@ -533,12 +923,13 @@ func TestUpdateSOSPost(t *testing.T) {
 			ImageIDs: []uuid.UUID{sosPostImage.ID, sosPostImage2.ID},
 			Reward:   "Reward2",
 			Dates: []sospost.SOSDateView{
-				{"2024-04-10", "2024-04-20"},
-				{"2024-05-10", "2024-05-20"},
+				{DateStartAt: "2024-04-10", DateEndAt: "2024-04-20"},
+				{DateStartAt: "2024-05-10", DateEndAt: "2024-05-20"},
 			},
 			CareType:     sospost.CareTypeFoster,
 			CarerGender:  sospost.CarerGenderMale,
 			RewardType:   sospost.RewardTypeFee,
+			RewardDetail: &sospost.RewardDetail{Amount: &rewardAmount, Unit: &rewardUnit},
 			ConditionIDs: conditionIDs,
 			PetIDs:       []uuid.UUID{addPets.Pets[0].ID},
 		}
@@ -556,11 +947,294 @@ func TestUpdateSOSPost(t *testing.T) {
 		assert.Equal(t, updateRequest.CareType, found.CareType)
 		assert.Equal(t, updateRequest.CarerGender, found.CarerGender)
 		assert.Equal(t, updateRequest.RewardType, found.RewardType)
+		assert.Equal(t, updateRequest.RewardDetail, found.RewardDetail)
 		assert.Equal(t, updateRequest.ImageIDs[0], found.ThumbnailID.UUID)
 		assert.Equal(t, updated.AuthorID, owner.ID)
 	})
 }
 
+func TestSOSPostStatus(t *testing.T) {
+	t.Run("게시글 상태를 open에서 matched, completed 순서로 변경한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		sosPost, _ := writeDummySOSPost(ctx, t, db)
+
+		// when
+		_, err := sosPostService.UpdateSOSPostStatus(ctx, sosPost.ID, sospost.StatusMatched)
+		assert.NoError(t, err)
+		completed, err := sosPostService.UpdateSOSPostStatus(ctx, sosPost.ID, sospost.StatusCompleted)
+		assert.NoError(t, err)
+
+		// then
+		assert.Equal(t, sospost.StatusCompleted, completed.Status)
+		openPosts, _ := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page: 1, Size: 20, SortBy: "newest", FilterType: "all", Status: sospost.StatusOpen,
+		})
+		assert.Empty(t, openPosts.Items)
+	})
+
+	t.Run("취소된 게시글은 다시 모집 중으로 변경할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		sosPost, _ := writeDummySOSPost(ctx, t, db)
+		sosPostService.UpdateSOSPostStatus(ctx, sosPost.ID, sospost.StatusCancelled)
+
+		// when
+		_, err := sosPostService.UpdateSOSPostStatus(ctx, sosPost.ID, sospost.StatusOpen)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeBadRequest, appErr.Code)
+	})
+
+	t.Run("삭제한 게시글은 조회되지 않는다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		sosPost, _ := writeDummySOSPost(ctx, t, db)
+
+		// when
+		err := sosPostService.DeleteSOSPost(ctx, sosPost.ID)
+
+		// then
+		assert.NoError(t, err)
+		_, err = sosPostService.FindSOSPostByID(ctx, sosPost.ID)
+		assert.Error(t, err)
+		posts, _ := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page: 1, Size: 20, SortBy: "newest", FilterType: "all",
+		})
+		assert.Empty(t, posts.Items)
+	})
+}
+
+func TestSOSPostBookmarks(t *testing.T) {
+	t.Run("저장한 게시글은 저장 목록과 게시글 목록에서 저장 여부가 표시된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		bookmarked, _ := writeDummySOSPost(ctx, t, db)
+		notBookmarked, _ := writeDummySOSPost(ctx, t, db)
+		sitter, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+
+		// when
+		err := sosPostService.BookmarkSOSPost(ctx, sitter.ID, bookmarked.ID)
+		assert.NoError(t, err)
+		// 같은 게시글을 다시 저장해도 오류가 발생하지 않는다.
+		err = sosPostService.BookmarkSOSPost(ctx, sitter.ID, bookmarked.ID)
+		assert.NoError(t, err)
+
+		// then
+		bookmarks, err := sosPostService.FindBookmarkedSOSPosts(ctx, sitter.ID, 1, 20)
+		assert.NoError(t, err)
+		assert.Len(t, bookmarks.Items, 1)
+		assert.Equal(t, bookmarked.ID, bookmarks.Items[0].ID)
+
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			ViewerID:    uuid.NullUUID{UUID: sitter.ID, Valid: true},
+			Page:        1,
+			Size:        20,
+			SortBy:      sospost.SortByNewest,
+			FilterType:  "all",
+			IncludePast: true,
+		})
+		assert.NoError(t, err)
+		for _, item := range found.Items {
+			assert.Equal(t, item.ID == bookmarked.ID, *item.IsBookmarked)
+			assert.Contains(t, []uuid.UUID{bookmarked.ID, notBookmarked.ID}, item.ID)
+		}
+	})
+
+	t.Run("저장을 취소한 게시글은 저장 목록에서 제외된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		sosPost, _ := writeDummySOSPost(ctx, t, db)
+		sitter, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		_ = sosPostService.BookmarkSOSPost(ctx, sitter.ID, sosPost.ID)
+
+		// when
+		err := sosPostService.UnbookmarkSOSPost(ctx, sitter.ID, sosPost.ID)
+
+		// then
+		assert.NoError(t, err)
+		bookmarks, _ := sosPostService.FindBookmarkedSOSPosts(ctx, sitter.ID, 1, 20)
+		assert.Empty(t, bookmarks.Items)
+	})
+}
+
+func TestFindSOSPostsByTimeSlots(t *testing.T) {
+	writeSOSPostsWithTimeSlots := func(
+		ctx context.Context, t *testing.T, db *database.DB, timeSlots [][]sospost.TimeSlot,
+	) []*sospost.DetailView {
+		t.Helper()
+		written := make([]*sospost.DetailView, len(timeSlots))
+		for i := range timeSlots {
+			written[i], _ = writeDummySOSPost(ctx, t, db, func(request *sospost.WriteSOSPostRequest) {
+				request.Dates = []sospost.SOSDateView{{
+					DateStartAt: time.Now().AddDate(0, 0, 1).Format(time.DateOnly),
+					DateEndAt:   time.Now().AddDate(0, 0, 3).Format(time.DateOnly),
+					TimeSlots:   timeSlots[i],
+					Timezone:    "Asia/Seoul",
+				}}
+			})
+		}
+		return written
+	}
+
+	t.Run("돌봄 시간대가 주어진 시간대와 겹치는 게시글만 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		written := writeSOSPostsWithTimeSlots(ctx, t, db, [][]sospost.TimeSlot{
+			{{StartTime: "08:00", EndTime: "09:00"}},
+			{{StartTime: "08:00", EndTime: "09:00"}, {StartTime: "18:00", EndTime: "19:00"}},
+			// 돌봄 시간대가 없으면 하루 종일 돌봄이 필요하다.
+			nil,
+		})
+
+		// when
+		timeFrom, timeTo := "17:00", "18:30"
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page:       1,
+			Size:       20,
+			SortBy:     sospost.SortByNewest,
+			FilterType: "all",
+			TimeFrom:   &timeFrom,
+			TimeTo:     &timeTo,
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, found.Items, 2)
+		for _, item := range found.Items {
+			assert.Contains(t, []uuid.UUID{written[1].ID, written[2].ID}, item.ID)
+		}
+	})
+
+	t.Run("마감순으로 조회하면 돌봄 시간대의 시작 시각이 이른 게시글부터 조회한다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		written := writeSOSPostsWithTimeSlots(ctx, t, db, [][]sospost.TimeSlot{
+			{{StartTime: "18:00", EndTime: "19:00"}},
+			{{StartTime: "08:00", EndTime: "09:00"}},
+		})
+
+		// when
+		found, err := sosPostService.FindSOSPosts(ctx, sospost.FindSOSPostsParams{
+			Page:       1,
+			Size:       20,
+			SortBy:     sospost.SortByDeadline,
+			FilterType: "all",
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, found.Items, 2)
+		assert.Equal(t, written[1].ID, found.Items[0].ID)
+		assert.Equal(t, written[0].ID, found.Items[1].ID)
+		assert.Equal(t, written[1].Dates, found.Items[0].Dates)
+	})
+}
+
+func TestRecordSOSPostView(t *testing.T) {
+	t.Run("같은 조회자가 같은 날 다시 조회하면 조회수가 오르지 않는다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// given
+		sosPost, _ := writeDummySOSPost(ctx, t, db)
+		viewer := sospost.ViewerKey(uuid.NullUUID{UUID: uuid.New(), Valid: true}, "127.0.0.1")
+		anonymous := sospost.ViewerKey(uuid.NullUUID{}, "127.0.0.1")
+
+		// when
+		for _, viewerKey := range []string{viewer, viewer, anonymous} {
+			err := sosPostService.RecordSOSPostView(ctx, sosPost.ID, viewerKey)
+			assert.NoError(t, err)
+		}
+
+		// then
+		found, err := sosPostService.FindSOSPostByID(ctx, sosPost.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, found.ViewCount)
+	})
+
+	t.Run("존재하지 않는 게시글은 조회를 기록할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := setUp(ctx, t)
+		defer tearDown(t)
+		sosPostService := tests.NewMockSOSPostService(db)
+
+		// when
+		err := sosPostService.RecordSOSPostView(ctx, uuid.New(), "ip:127.0.0.1")
+
+		// then
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+// 반려동물 한 마리와 돌봄 조건 하나로 돌봄 급구 게시글을 작성한다.
+// mutate가 주어지면 작성 요청을 바꾼 뒤 작성한다.
+func writeDummySOSPost(
+	ctx context.Context, t *testing.T, db *database.DB, mutate ...func(request *sospost.WriteSOSPostRequest),
+) (*sospost.DetailView, *user.InternalView) {
+	t.Helper()
+	userService := tests.NewMockUserService(db)
+	owner, err := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+	require.NoError(t, err)
+	addPets, err := userService.AddPetsToOwner(
+		ctx,
+		owner.FirebaseUID,
+		pet.AddPetsToOwnerRequest{Pets: []pet.AddPetRequest{
+			*tests.NewDummyAddPetRequest(uuid.NullUUID{}, commonvo.PetTypeDog, pet.GenderMale, "poodle"),
+		}},
+	)
+	require.NoError(t, err)
+	conditions, err := service.NewSOSConditionService(db).FindConditions(ctx)
+	require.NoError(t, err)
+
+	request := tests.NewDummyWriteSOSPostRequest(
+		[]uuid.UUID{},
+		[]uuid.UUID{addPets.Pets[0].ID},
+		0,
+		[]uuid.UUID{conditions[0].ID},
+	)
+	for _, m := range mutate {
+		m(request)
+	}
+	sosPost, err := tests.NewMockSOSPostService(db).WriteSOSPost(ctx, owner.FirebaseUID, request)
+	require.NoError(t, err)
+	return sosPost, owner
+}
+
 func assertPetEquals(t *testing.T, want, got pet.DetailView) {
 	t.Helper()
 
@@ -589,5 +1263,6 @@ func writtenAndFoundSOSPostEquals(
 	assert.Equal(t, want.CareType, got.CareType)
 	assert.Equal(t, want.CarerGender, got.CarerGender)
 	assert.Equal(t, want.RewardType, got.RewardType)
+	assert.Equal(t, want.RewardDetail, got.RewardDetail)
 	assert.Equal(t, want.ImageIDs[0], got.ThumbnailID.UUID)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
 
 	"github.com/stretchr/testify/assert"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 
 	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/pet"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/user"
 	"github.com/pet-sitter/pets-next-door-api/internal/tests"
@@ -470,3 +472,146 @@ func TestDeletePet(t *testing.T) {
 		assert.Equal(t, 0, len(found.Pets))
 	})
 }
+
+func TestBlockUser(t *testing.T) {
+	t.Run("차단한 사용자는 차단 목록에서 조회된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+
+		// given
+		blocker, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		blocked, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+
+		// when
+		err := userService.BlockUser(ctx, blocker.ID, blocked.ID)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		found, _ := userService.FindBlockedUsers(ctx, blocker.ID)
+		assert.Len(t, found.Items, 1)
+		assert.Equal(t, blocked.ID, found.Items[0].ID)
+	})
+
+	t.Run("차단한 사용자와는 1:1 채팅방을 만들 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		blocker, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		blocked, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		userService.BlockUser(ctx, blocker.ID, blocked.ID)
+
+		// when
+		_, err := chatService.FindOrCreateDirectRoom(ctx, blocked.ID, blocker.ID, uuid.NullUUID{})
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeUserBlocked, appErr.Code)
+	})
+
+	t.Run("차단한 사용자가 참여중인 채팅방에는 참여할 수 없다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		blocker, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		blocked, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, blocker.FirebaseUID)
+		_ = userService.BlockUser(ctx, blocker.ID, blocked.ID)
+
+		// when
+		_, err := chatService.JoinRoom(ctx, room.ID, blocked.FirebaseUID)
+
+		// then
+		var appErr *pnd.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, pnd.ErrCodeUserBlocked, appErr.Code)
+
+		memberIDs, _ := chatService.FindRoomMemberIDs(ctx, room.ID)
+		assert.Equal(t, []uuid.UUID{blocker.ID}, memberIDs)
+	})
+
+	t.Run("그룹 채팅방에서도 차단 관계인 사용자의 메시지는 조회되지 않는다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		blocker, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		blocked, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, blocker.FirebaseUID)
+		_, _ = chatService.JoinRoom(ctx, room.ID, blocked.FirebaseUID)
+		_ = userService.BlockUser(ctx, blocker.ID, blocked.ID)
+		_, _, err := chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: blocked.ID, MessageType: chat.PlainMessage, Content: "비밀번호 알려주세요",
+		})
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// when
+		found, err := chatService.FindChatRoomMessagesByRoomID(
+			ctx, room.ID, blocker.ID, uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+		searched, err := chatService.SearchMessages(
+			ctx, room.ID, blocker.ID, "비밀번호", uuid.NullUUID{}, uuid.NullUUID{}, 30,
+		)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Empty(t, *found.Items)
+		assert.Empty(t, searched.Items)
+	})
+
+	t.Run("채팅방 목록에서도 차단 관계인 사용자의 메시지는 마지막 메시지와 읽지 않은 메시지 수에서 제외된다", func(t *testing.T) {
+		ctx := context.Background()
+		db, tearDown := tests.SetUp(t)
+		defer tearDown(t)
+		userService := tests.NewMockUserService(db)
+		chatService := tests.NewMockChatService(db)
+
+		// given
+		blocker, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		member, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		blocked, _ := userService.RegisterUser(ctx, tests.NewDummyRegisterUserRequest(uuid.NullUUID{}))
+		room, _ := chatService.CreateRoom(ctx, "room", chat.EventRoomType, blocker.FirebaseUID)
+		_, _ = chatService.JoinRoom(ctx, room.ID, member.FirebaseUID)
+		_, _ = chatService.JoinRoom(ctx, room.ID, blocked.FirebaseUID)
+		_, _, _ = chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: member.ID, MessageType: chat.PlainMessage, Content: "hello",
+		})
+		_, _, _ = chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+			RoomID: room.ID, UserID: blocked.ID, MessageType: chat.PlainMessage, Content: "비밀번호 알려주세요",
+		})
+		_ = userService.BlockUser(ctx, blocker.ID, blocked.ID)
+
+		// when
+		found, err := chatService.FindAllByUserUID(ctx, blocker.FirebaseUID)
+		if err != nil {
+			t.Errorf("got %v want %v", err, nil)
+		}
+
+		// then
+		assert.Len(t, found.Items, 1)
+		assert.Equal(t, int64(1), found.Items[0].UnreadCount)
+		assert.Equal(t, "hello", found.Items[0].LastMessage.Content)
+	})
+}
@@ -151,6 +151,30 @@ func (service *UserService) UpdateUserByUID(
 	return user.ToWithProfileImage(refreshedUser).ToMyProfileView(), nil
 }
 
+// 내 위치를 수정한다. 돌봄급구 게시글을 거리순으로 조회할 때 기준 좌표로 사용된다.
+func (service *UserService) UpdateUserLocationByUID(
+	ctx context.Context, uid string, request *user.UpdateLocationRequest,
+) (*user.MyProfileView, error) {
+	q := databasegen.New(service.conn)
+	if err := q.UpdateUserLocationByFbUID(ctx, databasegen.UpdateUserLocationByFbUIDParams{
+		Region:    utils.StrPtrToNullStr(request.Region),
+		Latitude:  request.Location.NullLatitude(),
+		Longitude: request.Location.NullLongitude(),
+		FbUid:     utils.StrToNullStr(uid),
+	}); err != nil {
+		return nil, err
+	}
+
+	refreshedUser, err := q.FindUser(ctx, databasegen.FindUserParams{
+		FbUid: utils.StrToNullStr(uid),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user.ToWithProfileImage(refreshedUser).ToMyProfileView(), nil
+}
+
 func (service *UserService) DeleteUserByUID(ctx context.Context, uid string) error {
 	tx, err := service.conn.BeginTx(ctx)
 	if err != nil {
@@ -165,6 +189,44 @@ func (service *UserService) DeleteUserByUID(ctx context.Context, uid string) err
 	return tx.Commit()
 }
 
+// 다른 사용자를 차단한다. 차단한 사용자와는 1:1 채팅을 할 수 없고, 그 사용자의 게시글은 보이지 않는다.
+func (service *UserService) BlockUser(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	if blockerID == blockedID {
+		return pnd.ErrBadRequest(errors.New("cannot block yourself"))
+	}
+
+	q := databasegen.New(service.conn)
+	if _, err := q.FindUser(ctx, databasegen.FindUserParams{
+		ID: uuid.NullUUID{UUID: blockedID, Valid: true},
+	}); err != nil {
+		return err
+	}
+
+	return q.BlockUser(ctx, databasegen.BlockUserParams{
+		ID:        datatype.NewUUIDV7(),
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+	})
+}
+
+func (service *UserService) UnblockUser(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	return databasegen.New(service.conn).UnblockUser(ctx, databasegen.UnblockUserParams{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+	})
+}
+
+func (service *UserService) FindBlockedUsers(
+	ctx context.Context, blockerID uuid.UUID,
+) (*user.BlockedUserListView, error) {
+	rows, err := databasegen.New(service.conn).FindBlockedUsers(ctx, blockerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.ToBlockedUserListView(rows), nil
+}
+
 func (service *UserService) FindPet(
 	ctx context.Context, params pet.FindPetParams,
 ) (*pet.WithProfileImage, error) {
@@ -12,11 +12,16 @@ import (
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/sospost"
 )
 
+// DatesEquals 요청에서 생략한 시간대와 돌봄 시간대는 기본값으로 채워서 비교한다.
 func DatesEquals(t *testing.T, want, got []sospost.SOSDateView) {
 	t.Helper()
 
-	if !reflect.DeepEqual(got, want) {
-		t.Errorf("got %v want %v", got, want)
+	wantWithDefaults := make([]sospost.SOSDateView, len(want))
+	for i, date := range want {
+		wantWithDefaults[i] = date.WithDefaults()
+	}
+	if !reflect.DeepEqual(got, wantWithDefaults) {
+		t.Errorf("got %v want %v", got, wantWithDefaults)
 	}
 }
 
@@ -47,6 +47,8 @@ func NewDummyWriteSOSPostRequest(
 	sosPostCnt int,
 	conditionIDs []uuid.UUID,
 ) *sospost.WriteSOSPostRequest {
+	rewardAmount := 10000
+	rewardUnit := sospost.RewardUnitPerVisit
 	return &sospost.WriteSOSPostRequest{
 		Title:    fmt.Sprintf("Title%d", sosPostCnt),
 		Content:  fmt.Sprintf("Content%d", sosPostCnt),
@@ -65,6 +67,7 @@ func NewDummyWriteSOSPostRequest(
 		CareType:     sospost.CareTypeFoster,
 		CarerGender:  sospost.CarerGenderMale,
 		RewardType:   sospost.RewardTypeFee,
+		RewardDetail: &sospost.RewardDetail{Amount: &rewardAmount, Unit: &rewardUnit},
 		ConditionIDs: conditionIDs,
 		PetIDs:       petIDs,
 	}
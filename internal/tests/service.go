@@ -12,6 +12,7 @@ import (
 	"github.com/pet-sitter/pets-next-door-api/internal/infra/database"
 
 	bucketinfra "github.com/pet-sitter/pets-next-door-api/internal/infra/bucket"
+	firebaseinfra "github.com/pet-sitter/pets-next-door-api/internal/infra/firebase"
 
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/pet"
 	"github.com/pet-sitter/pets-next-door-api/internal/service"
@@ -42,6 +43,10 @@ func NewMockSOSPostService(db *database.DB) *service.SOSPostService {
 	return service.NewSOSPostService(db)
 }
 
+func NewMockSOSApplicationService(db *database.DB) *service.SOSApplicationService {
+	return service.NewSOSApplicationService(db)
+}
+
 func NewMockSOSConditionService(db *database.DB) *service.SOSConditionService {
 	return service.NewSOSConditionService(db)
 }
@@ -50,6 +55,10 @@ func NewMockChatService(db *database.DB) *service.ChatService {
 	return service.NewChatService(db)
 }
 
+func NewMockNotificationService(db *database.DB, notifier firebaseinfra.Notifier) *service.NotificationService {
+	return service.NewNotificationService(db, notifier)
+}
+
 func AddDummyPet(
 	t *testing.T,
 	ctx context.Context,
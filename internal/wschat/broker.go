@@ -0,0 +1,225 @@
+package wschat
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pet-sitter/pets-next-door-api/internal/datatype"
+	databasegen "github.com/pet-sitter/pets-next-door-api/internal/infra/database/gen"
+	"github.com/rs/zerolog/log"
+)
+
+// Envelope 인스턴스 간에 전달되는 메시지
+type Envelope struct {
+	RoomID       uuid.UUID   `json:"roomId"`
+	RecipientIDs []uuid.UUID `json:"recipientIds"`
+	// 메시지를 보낸 연결. 이 연결에는 메시지를 전달하지 않는다.
+	OriginClientID uuid.UUID       `json:"originClientId"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+	// 접속 상태 변경인 경우 함께 전달된다.
+	Presence *Presence `json:"presence,omitempty"`
+}
+
+// Broker 여러 서버 인스턴스에 연결된 클라이언트에게 메시지를 전달하기 위한 pub/sub 인터페이스
+type Broker interface {
+	Publish(ctx context.Context, envelope Envelope) error
+	// Subscribe 발행된 모든 Envelope에 대해 handler를 호출한다. ctx가 취소되면 구독을 종료한다.
+	Subscribe(ctx context.Context, handler func(Envelope)) error
+	Close() error
+}
+
+// InMemoryBroker 단일 프로세스 안에서만 메시지를 전달한다. 로컬 개발과 테스트에 적합하다.
+type InMemoryBroker struct {
+	mu       sync.RWMutex
+	handlers []func(Envelope)
+}
+
+func NewInMemoryBroker() *InMemoryBroker {
+	return &InMemoryBroker{}
+}
+
+func (b *InMemoryBroker) Publish(_ context.Context, envelope Envelope) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, handler := range b.handlers {
+		handler(envelope)
+	}
+	return nil
+}
+
+func (b *InMemoryBroker) Subscribe(_ context.Context, handler func(Envelope)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers = append(b.handlers, handler)
+	return nil
+}
+
+func (b *InMemoryBroker) Close() error {
+	return nil
+}
+
+const (
+	postgresBrokerChannel = "wschat"
+	// PostgreSQL NOTIFY payload의 최대 크기는 8000 bytes이다.
+	maxNotifyPayloadSize = 8000
+)
+
+// postgresNotification NOTIFY로 전달하는 내용.
+// Envelope가 NOTIFY payload 크기 제한을 넘으면 chat_envelopes 테이블에 저장하고 ID만 전달한다.
+type postgresNotification struct {
+	*Envelope
+	StoredEnvelopeID *uuid.UUID `json:"storedEnvelopeId,omitempty"`
+}
+
+// PostgresBroker PostgreSQL LISTEN/NOTIFY를 이용해 모든 인스턴스에 메시지를 전달한다.
+type PostgresBroker struct {
+	databaseURL string
+	db          *sql.DB
+
+	mu        sync.Mutex
+	listeners []*pq.Listener
+}
+
+func NewPostgresBroker(databaseURL string) (*PostgresBroker, error) {
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgresBroker{
+		databaseURL: databaseURL,
+		db:          db,
+	}, nil
+}
+
+func (b *PostgresBroker) newListener() *pq.Listener {
+	return pq.NewListener(b.databaseURL, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Error().Err(err).Int("event", int(ev)).Msg("Chat broker listener event")
+		}
+	})
+}
+
+func (b *PostgresBroker) Publish(ctx context.Context, envelope Envelope) error {
+	payload, err := json.Marshal(postgresNotification{Envelope: &envelope})
+	if err != nil {
+		return err
+	}
+	if len(payload) >= maxNotifyPayloadSize {
+		if payload, err = b.storeEnvelope(ctx, envelope); err != nil {
+			return err
+		}
+	}
+
+	_, err = b.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", postgresBrokerChannel, string(payload))
+	return err
+}
+
+// storeEnvelope Envelope를 테이블에 저장하고, 저장한 Envelope의 ID만 담은 NOTIFY payload를 반환한다.
+func (b *PostgresBroker) storeEnvelope(ctx context.Context, envelope Envelope) ([]byte, error) {
+	stored, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	q := databasegen.New(b.db)
+	if err := q.DeleteExpiredChatEnvelopes(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to delete expired chat envelopes")
+	}
+	id := datatype.NewUUIDV7()
+	if err := q.CreateChatEnvelope(ctx, databasegen.CreateChatEnvelopeParams{ID: id, Envelope: stored}); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(postgresNotification{StoredEnvelopeID: &id})
+}
+
+// loadEnvelope NOTIFY로 전달받은 내용을 Envelope로 해석한다. 저장된 Envelope이면 테이블에서 읽는다.
+func (b *PostgresBroker) loadEnvelope(ctx context.Context, payload string) (Envelope, error) {
+	var notification postgresNotification
+	if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+		return Envelope{}, err
+	}
+	if notification.StoredEnvelopeID == nil {
+		if notification.Envelope == nil {
+			return Envelope{}, nil
+		}
+		return *notification.Envelope, nil
+	}
+
+	stored, err := databasegen.New(b.db).FindChatEnvelope(ctx, *notification.StoredEnvelopeID)
+	if err != nil {
+		return Envelope{}, err
+	}
+	var envelope Envelope
+	if err := json.Unmarshal(stored, &envelope); err != nil {
+		return Envelope{}, err
+	}
+	return envelope, nil
+}
+
+func (b *PostgresBroker) Subscribe(ctx context.Context, handler func(Envelope)) error {
+	listener := b.newListener()
+	if err := listener.Listen(postgresBrokerChannel); err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	b.mu.Lock()
+	b.listeners = append(b.listeners, listener)
+	b.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(90 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n, ok := <-listener.Notify:
+				if !ok {
+					return
+				}
+				// 재연결 직후에는 nil이 전달된다.
+				if n == nil {
+					continue
+				}
+
+				envelope, err := b.loadEnvelope(ctx, n.Extra)
+				if err != nil {
+					log.Error().Err(err).Msg("Failed to load chat envelope")
+					continue
+				}
+				handler(envelope)
+			case <-ticker.C:
+				if err := listener.Ping(); err != nil {
+					log.Error().Err(err).Msg("Chat broker listener ping failed")
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *PostgresBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var errs []error
+	for _, listener := range b.listeners {
+		errs = append(errs, listener.Close())
+	}
+	b.listeners = nil
+	errs = append(errs, b.db.Close())
+	return errors.Join(errs...)
+}
@@ -0,0 +1,107 @@
+package wschat_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pet-sitter/pets-next-door-api/internal/configs"
+	"github.com/pet-sitter/pets-next-door-api/internal/tests"
+	"github.com/pet-sitter/pets-next-door-api/internal/wschat"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresBroker(t *testing.T) {
+	if configs.DatabaseURL == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+
+	t.Run("다른 인스턴스에서 발행한 메시지를 전달받는다", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// given
+		subscriber, err := wschat.NewPostgresBroker(tests.TestDatabaseURL)
+		if err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+		defer subscriber.Close()
+		publisher, err := wschat.NewPostgresBroker(tests.TestDatabaseURL)
+		if err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+		defer publisher.Close()
+
+		received := make(chan wschat.Envelope, 1)
+		if err := subscriber.Subscribe(ctx, func(envelope wschat.Envelope) {
+			received <- envelope
+		}); err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+
+		// when
+		envelope := wschat.Envelope{
+			RoomID:         uuid.New(),
+			RecipientIDs:   []uuid.UUID{uuid.New(), uuid.New()},
+			OriginClientID: uuid.New(),
+			Payload:        json.RawMessage(`{"message":"hello"}`),
+		}
+		if err := publisher.Publish(ctx, envelope); err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+
+		// then
+		select {
+		case got := <-received:
+			assert.Equal(t, envelope.RoomID, got.RoomID)
+			assert.Equal(t, envelope.RecipientIDs, got.RecipientIDs)
+			assert.Equal(t, envelope.OriginClientID, got.OriginClientID)
+			assert.JSONEq(t, string(envelope.Payload), string(got.Payload))
+		case <-time.After(5 * time.Second):
+			t.Errorf("envelope was not delivered")
+		}
+	})
+
+	t.Run("NOTIFY 크기 제한을 넘는 메시지도 다른 인스턴스에 전달한다", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// given
+		subscriber, err := wschat.NewPostgresBroker(tests.TestDatabaseURL)
+		if err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+		defer subscriber.Close()
+		publisher, err := wschat.NewPostgresBroker(tests.TestDatabaseURL)
+		if err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+		defer publisher.Close()
+
+		received := make(chan wschat.Envelope, 1)
+		if err := subscriber.Subscribe(ctx, func(envelope wschat.Envelope) {
+			received <- envelope
+		}); err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+
+		// when
+		large, _ := json.Marshal(map[string]string{"message": strings.Repeat("a", 8000)})
+		envelope := wschat.Envelope{RoomID: uuid.New(), Payload: large}
+		if err := publisher.Publish(ctx, envelope); err != nil {
+			t.Fatalf("got %v want %v", err, nil)
+		}
+
+		// then
+		select {
+		case got := <-received:
+			assert.Equal(t, envelope.RoomID, got.RoomID)
+			assert.JSONEq(t, string(envelope.Payload), string(got.Payload))
+		case <-time.After(5 * time.Second):
+			t.Errorf("envelope was not delivered")
+		}
+	})
+}
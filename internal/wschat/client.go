@@ -0,0 +1,131 @@
+package wschat
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// 메시지 하나를 쓰는 데 허용되는 최대 시간
+	writeWait = 10 * time.Second
+	// 클라이언트로부터 pong을 기다리는 최대 시간
+	pongWait = 60 * time.Second
+	// ping 전송 주기. pongWait보다 짧아야 한다.
+	pingPeriod = (pongWait * 9) / 10
+	// 클라이언트가 보낼 수 있는 메시지의 최대 크기
+	maxMessageSize = 64 * 1024
+	// 클라이언트별 전송 큐의 크기. 큐가 가득 차면 느린 클라이언트로 보고 연결을 끊는다.
+	sendBufferSize = 256
+)
+
+type WSClient struct {
+	// 연결마다 부여되는 ID. 같은 사용자의 여러 기기를 구분한다.
+	id     uuid.UUID
+	conn   *websocket.Conn
+	userID uuid.UUID
+
+	// 전송할 메시지 큐. writePump만 연결에 쓰므로 쓰기가 직렬화된다.
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewWSClient(
+	conn *websocket.Conn,
+	userID uuid.UUID,
+) *WSClient {
+	return &WSClient{
+		id:     uuid.New(),
+		conn:   conn,
+		userID: userID,
+		send:   make(chan []byte, sendBufferSize),
+		done:   make(chan struct{}),
+	}
+}
+
+// Send 메시지를 전송 큐에 넣는다. 연결이 닫혔거나 큐가 가득 찬 경우 false를 반환한다.
+func (c *WSClient) Send(payload []byte) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	select {
+	case c.send <- payload:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close 연결을 닫는다. 여러 번 호출해도 안전하다.
+func (c *WSClient) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+		err = c.conn.Close()
+	})
+	return err
+}
+
+// readPump 연결이 끊어지거나 pong이 제때 오지 않을 때까지 메시지를 읽어 handle에 전달한다.
+func (c *WSClient) readPump(handle func(payload []byte)) {
+	c.conn.SetReadLimit(maxMessageSize)
+	if err := c.conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+		log.Error().Err(err).Msg("Failed to set read deadline")
+		return
+	}
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, payload, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				log.Error().Err(err).Msg("Failed to read message")
+			}
+			return
+		}
+
+		handle(payload)
+	}
+}
+
+// writePump 전송 큐의 메시지를 연결에 쓰고, 주기적으로 ping을 보낸다.
+func (c *WSClient) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		if err := c.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close connection")
+		}
+	}()
+
+	for {
+		select {
+		case payload := <-c.send:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				log.Error().Err(err).Msg("Failed to write message")
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
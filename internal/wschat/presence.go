@@ -0,0 +1,71 @@
+package wschat
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// 연결된 사용자의 접속 상태를 다른 인스턴스에 알리는 주기
+	presenceHeartbeatPeriod = 30 * time.Second
+	// 이 시간 동안 heartbeat가 없으면 해당 인스턴스의 접속 정보는 만료된 것으로 본다.
+	presenceTTL = presenceHeartbeatPeriod*2 + 15*time.Second
+)
+
+// Presence 사용자의 접속 상태 변경. 어느 인스턴스에서 발생했는지 함께 전달한다.
+type Presence struct {
+	UserID     uuid.UUID `json:"userId"`
+	InstanceID uuid.UUID `json:"instanceId"`
+	Online     bool      `json:"online"`
+}
+
+// presenceTracker broker로 전달받은 Presence를 바탕으로 클러스터 전체의 접속 상태를 관리한다.
+// 인스턴스가 비정상 종료되어 offline을 보내지 못해도 TTL이 지나면 접속하지 않은 것으로 본다.
+type presenceTracker struct {
+	mu sync.Mutex
+	// key: UserID, value: 인스턴스별 마지막 heartbeat 시각
+	seen map[uuid.UUID]map[uuid.UUID]time.Time
+}
+
+func newPresenceTracker() *presenceTracker {
+	return &presenceTracker{
+		seen: make(map[uuid.UUID]map[uuid.UUID]time.Time),
+	}
+}
+
+func (t *presenceTracker) update(presence Presence, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	instances, ok := t.seen[presence.UserID]
+	if !presence.Online {
+		if !ok {
+			return
+		}
+		delete(instances, presence.InstanceID)
+		if len(instances) == 0 {
+			delete(t.seen, presence.UserID)
+		}
+		return
+	}
+
+	if !ok {
+		instances = make(map[uuid.UUID]time.Time)
+		t.seen[presence.UserID] = instances
+	}
+	instances[presence.InstanceID] = now
+}
+
+func (t *presenceTracker) isOnline(userID uuid.UUID, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, seenAt := range t.seen[userID] {
+		if now.Sub(seenAt) < presenceTTL {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package wschat
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// clientRegistry는 현재 연결된 WSClient를 사용자별로 관리한다.
+// 한 사용자가 여러 기기에서 동시에 접속할 수 있으므로, 사용자 ID마다 여러 개의 연결을 가진다.
+type clientRegistry struct {
+	mu sync.RWMutex
+	// key: UserID, value: 해당 사용자의 연결 목록
+	clients map[uuid.UUID]map[*WSClient]struct{}
+}
+
+func newClientRegistry() *clientRegistry {
+	return &clientRegistry{
+		clients: make(map[uuid.UUID]map[*WSClient]struct{}),
+	}
+}
+
+// register는 연결을 목록에 추가한다. 사용자의 첫 번째 연결이면 true를 반환한다.
+func (r *clientRegistry) register(client *WSClient) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conns, ok := r.clients[client.userID]
+	if !ok {
+		conns = make(map[*WSClient]struct{})
+		r.clients[client.userID] = conns
+	}
+	conns[client] = struct{}{}
+	return !ok
+}
+
+// unregister는 연결을 목록에서 제거한다. 이미 제거된 연결이면 removed가 false이고,
+// 사용자의 마지막 연결이 제거되었으면 last가 true이다.
+func (r *clientRegistry) unregister(client *WSClient) (removed, last bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conns, ok := r.clients[client.userID]
+	if !ok {
+		return false, false
+	}
+	if _, ok := conns[client]; !ok {
+		return false, false
+	}
+
+	delete(conns, client)
+	if len(conns) == 0 {
+		delete(r.clients, client.userID)
+		return true, true
+	}
+	return true, false
+}
+
+// clientsOf는 사용자의 모든 연결을 반환한다.
+func (r *clientRegistry) clientsOf(userID uuid.UUID) []*WSClient {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	conns := r.clients[userID]
+	clients := make([]*WSClient, 0, len(conns))
+	for client := range conns {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+// userIDs는 현재 연결된 사용자 ID 목록을 반환한다.
+func (r *clientRegistry) userIDs() []uuid.UUID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]uuid.UUID, 0, len(r.clients))
+	for userID := range r.clients {
+		ids = append(ids, userID)
+	}
+	return ids
+}
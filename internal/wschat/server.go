@@ -2,39 +2,78 @@ package wschat
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
 	"net/http"
-	"strconv"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
+	pnd "github.com/pet-sitter/pets-next-door-api/api"
+	"github.com/pet-sitter/pets-next-door-api/internal/domain/chat"
 	"github.com/pet-sitter/pets-next-door-api/internal/domain/media"
 	"github.com/pet-sitter/pets-next-door-api/internal/service"
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	// 푸시 알림 전송을 기다리는 최대 시간
+	pushTimeout = 10 * time.Second
+	// 클라이언트로부터 받아 아직 worker에 넘기지 않은 메시지 큐의 크기
+	broadcastBufferSize = 1024
+	// 채팅방 메시지를 처리하는 worker 수. 같은 채팅방의 메시지는 항상 같은 worker가 순서대로 처리한다.
+	roomWorkerCount = 16
+	// worker별 처리 대기 큐의 크기. 큐가 가득 차면 다른 채팅방을 막지 않도록 메시지를 거절한다.
+	roomWorkerQueueSize = 256
+)
+
 type WSServer struct {
-	// key: UserID, value: WSClient
-	clients   map[uuid.UUID]WSClient
-	broadcast chan MessageRequest
+	clients   *clientRegistry
+	broadcast chan inboundMessage
 	upgrader  websocket.Upgrader
+	broker    Broker
+
+	// 채팅방별로 메시지를 처리하는 worker의 큐. DB 작업은 worker에서만 한다.
+	roomWorkers []chan inboundMessage
+
+	// 이 인스턴스를 식별하는 ID. 접속 상태를 인스턴스별로 관리하는 데 사용한다.
+	instanceID uuid.UUID
+	presence   *presenceTracker
 
-	authService  service.AuthService
-	mediaService service.MediaService
+	authService         service.AuthService
+	mediaService        service.MediaService
+	chatService         service.ChatService
+	notificationService service.NotificationService
 }
 
 func NewWSServer(
 	upgrader websocket.Upgrader,
+	broker Broker,
 	authService service.AuthService,
 	mediaService service.MediaService,
+	chatService service.ChatService,
+	notificationService service.NotificationService,
 ) *WSServer {
+	roomWorkers := make([]chan inboundMessage, roomWorkerCount)
+	for i := range roomWorkers {
+		roomWorkers[i] = make(chan inboundMessage, roomWorkerQueueSize)
+	}
+
 	return &WSServer{
-		clients:      make(map[uuid.UUID]WSClient),
-		broadcast:    make(chan MessageRequest),
-		upgrader:     upgrader,
-		authService:  authService,
-		mediaService: mediaService,
+		clients:             newClientRegistry(),
+		broadcast:           make(chan inboundMessage, broadcastBufferSize),
+		roomWorkers:         roomWorkers,
+		upgrader:            upgrader,
+		broker:              broker,
+		instanceID:          uuid.New(),
+		presence:            newPresenceTracker(),
+		authService:         authService,
+		mediaService:        mediaService,
+		chatService:         chatService,
+		notificationService: notificationService,
 	}
 }
 
@@ -66,130 +105,511 @@ func (s *WSServer) HandleConnections(
 	userID := foundUser.ID
 
 	conn, err := s.upgrader.Upgrade(c.Response().Writer, c.Request(), nil)
-	defer func() {
-		err := conn.Close()
-		if err != nil {
-			log.Error().Err(err).Msg("Failed to close connection")
-		}
-		delete(s.clients, userID)
-	}()
-
 	if err != nil {
+		// Upgrade 실패 시 이미 HTTP 에러 응답이 전송된다.
 		log.Error().Err(err).Msg("Failed to upgrade connection")
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+		return nil
 	}
 
 	client := NewWSClient(conn, userID)
-	s.clients[userID] = client
+	if s.clients.register(client) {
+		s.publishPresence(c.Request().Context(), userID, true)
+	}
+	defer s.removeClient(client)
 
-	for {
-		var msgReq MessageRequest
-		err := conn.ReadJSON(&msgReq)
-		msgReq.Sender = Sender{ID: userID}
-		if err != nil {
-			log.Error().Err(err).Msg("Failed to read message")
-			delete(s.clients, userID)
+	go client.writePump()
 
-			return c.JSON(
-				http.StatusInternalServerError,
-				map[string]interface{}{"error": err.Error()},
+	// 연결이 끊어질 때까지 클라이언트의 메시지를 읽는다.
+	client.readPump(func(payload []byte) {
+		var msgReq MessageRequest
+		if err := json.Unmarshal(payload, &msgReq); err != nil {
+			log.Error().Err(err).Msg("Failed to parse message")
+			s.sendErrorToSender(
+				client, MessageRequest{Sender: Sender{ID: userID}},
+				pnd.ErrInvalidBody(errors.New("invalid message format")),
 			)
+			return
 		}
+		msgReq.Sender = Sender{ID: userID}
 
-		s.broadcast <- msgReq
+		s.broadcast <- inboundMessage{client: client, request: msgReq}
+	})
+
+	return nil
+}
+
+// removeClient 연결을 레지스트리에서 제거하고 닫는다.
+func (s *WSServer) removeClient(client *WSClient) {
+	removed, last := s.clients.unregister(client)
+	if !removed {
+		return
+	}
+	if err := client.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close connection")
+	}
+	if last {
+		s.publishPresence(context.Background(), client.userID, false)
 	}
 }
 
-// Broadcast messages to all clients
+// LoopOverClientMessages 클라이언트로부터 받은 메시지를 채팅방별 worker에 나누어 넘긴다.
+// 이 loop는 DB에 접근하지 않으므로 한 채팅방의 느린 처리가 다른 채팅방의 전달을 막지 않는다.
 func (s *WSServer) LoopOverClientMessages() {
 	log.Info().Msg("Looping over client messages")
 	ctx := context.Background()
 
+	for _, queue := range s.roomWorkers {
+		go s.loopOverRoomMessages(ctx, queue)
+	}
+
+	for {
+		inbound := <-s.broadcast
+		msgReq := inbound.request
+
+		// 메시지 내용은 남기지 않는다.
+		log.Debug().
+			Str("roomID", msgReq.Room.ID.String()).
+			Str("senderID", msgReq.Sender.ID.String()).
+			Str("messageID", msgReq.MessageID).
+			Msg("Received chat message")
+
+		select {
+		case s.roomWorkerOf(msgReq.Room.ID) <- inbound:
+		default:
+			log.Warn().Str("roomID", msgReq.Room.ID.String()).Msg("Room worker queue is full")
+			s.sendErrorToSender(
+				inbound.client, msgReq, pnd.ErrServerBusy(errors.New("too many messages are being processed")),
+			)
+		}
+	}
+}
+
+// roomWorkerOf 채팅방의 메시지를 처리할 worker의 큐를 반환한다.
+func (s *WSServer) roomWorkerOf(roomID uuid.UUID) chan inboundMessage {
+	hash := fnv.New32a()
+	_, _ = hash.Write(roomID[:])
+	return s.roomWorkers[hash.Sum32()%uint32(len(s.roomWorkers))]
+}
+
+// loopOverRoomMessages worker에 넘겨진 메시지를 순서대로 처리한다.
+func (s *WSServer) loopOverRoomMessages(ctx context.Context, queue <-chan inboundMessage) {
+	for inbound := range queue {
+		s.handleInbound(ctx, inbound)
+	}
+}
+
+// handleInbound 채팅방 참여자인지 확인한 뒤 메시지 종류에 따라 처리한다.
+func (s *WSServer) handleInbound(ctx context.Context, inbound inboundMessage) {
+	msgReq := inbound.request
+
+	// 채팅방에 현재 참여중인 사용자에게만 메시지를 전달한다.
+	memberIDs, err := s.chatService.FindRoomMemberIDs(ctx, msgReq.Room.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find room members")
+		s.sendErrorToSender(inbound.client, msgReq, err)
+		return
+	}
+
+	if !slices.Contains(memberIDs, msgReq.Sender.ID) {
+		log.Error().Msg("Sender is not a member of the room")
+		s.sendErrorToSender(
+			inbound.client, msgReq, pnd.ErrNotRoomMember(errors.New("not a member of the room")),
+		)
+		return
+	}
+
+	switch msgReq.MessageType {
+	case chat.PlainMessage, chat.MediaMessage:
+		s.handleWriteMessage(ctx, inbound, memberIDs)
+	case "edit", "delete":
+		s.handleModifyMessage(ctx, inbound, memberIDs)
+	case "typing":
+		s.handleTyping(ctx, inbound, memberIDs)
+	case "read":
+		s.handleRead(ctx, inbound, memberIDs)
+	default:
+		log.Error().Msg("Unknown message type")
+		s.sendErrorToSender(
+			inbound.client, msgReq, pnd.ErrInvalidMessageType(errors.New("unknown message type")),
+		)
+	}
+}
+
+// handleWriteMessage 메시지를 저장한 뒤 보낸 연결에는 ack를, 다른 참여자에게는 메시지를 전달한다.
+// 재전송된 메시지는 이미 다른 참여자에게 전달되었으므로 ack만 다시 보낸다.
+func (s *WSServer) handleWriteMessage(ctx context.Context, inbound inboundMessage, memberIDs []uuid.UUID) {
+	msgReq := inbound.request
+	mediaIDs := msgReq.mediaIDs()
+	saved, created, err := s.chatService.WriteMessage(ctx, &chat.WriteMessageRequest{
+		RoomID:          msgReq.Room.ID,
+		UserID:          msgReq.Sender.ID,
+		ClientMessageID: msgReq.MessageID,
+		MessageType:     msgReq.MessageType,
+		Content:         msgReq.Message,
+		MediaIDs:        mediaIDs,
+		ReplyToID:       msgReq.replyToID(),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to save message")
+		s.sendErrorToSender(inbound.client, msgReq, err)
+		return
+	}
+
+	// 보낸 사용자에게는 저장된 메시지의 ID와 생성 시각을 ack로 전달한다.
+	s.send(inbound.client, NewAckMessageResponse(
+		saved.ID,
+		msgReq.MessageID,
+		msgReq.Sender,
+		msgReq.Room,
+		saved.CreatedAt,
+	))
+	if !created {
+		return
+	}
+
+	recipientIDs, err := s.filterBlockedMembers(ctx, msgReq.Sender.ID, memberIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find blocked users")
+		return
+	}
+
+	var msg MessageResponse
+	if saved.MessageType == chat.MediaMessage {
+		medias, err := s.mediaService.FindMediasByIDs(ctx, mediaIDs)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to find media")
+			return
+		}
+		msg = NewMediaMessageResponse(
+			saved.ID,
+			msgReq.MessageID,
+			msgReq.Sender,
+			msgReq.Room,
+			medias,
+			saved.CreatedAt,
+		)
+		msg.Message = saved.Content
+	} else {
+		msg = NewPlainMessageResponse(
+			saved.ID,
+			msgReq.MessageID,
+			msgReq.Sender,
+			msgReq.Room,
+			saved.Content,
+			saved.CreatedAt,
+		)
+	}
+	msg.ReplyToID = saved.ReplyToID
+
+	// 보낸 연결을 제외한 채팅방 참여자의 모든 연결에 메시지를 전달한다.
+	// 다른 인스턴스에 연결된 사용자에게도 전달되도록 broker를 거친다.
+	s.publish(ctx, msgReq.Room.ID, recipientIDs, inbound.client, msg)
+
+	// 접속 중인 연결이 없는 참여자에게는 푸시 알림을 보낸다.
+	go s.notifyOfflineMembers(recipientIDs, saved)
+}
+
+// notifyOfflineMembers 어느 인스턴스에도 접속해 있지 않은 참여자에게 새 메시지 알림을 보낸다.
+func (s *WSServer) notifyOfflineMembers(memberIDs []uuid.UUID, message *chat.Message) {
+	online := s.OnlineUserIDs(memberIDs)
+	offline := make([]uuid.UUID, 0)
+	for _, memberID := range memberIDs {
+		if memberID != message.UserID && !slices.Contains(online, memberID) {
+			offline = append(offline, memberID)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pushTimeout)
+	defer cancel()
+	if err := s.notificationService.NotifyChatMessage(ctx, offline, message); err != nil {
+		log.Error().Err(err).Msg("Failed to send push notification")
+	}
+}
+
+// handleModifyMessage 메시지를 수정하거나 삭제한 뒤, 보낸 사용자를 포함한 모든 참여자에게 전달한다.
+func (s *WSServer) handleModifyMessage(ctx context.Context, inbound inboundMessage, memberIDs []uuid.UUID) {
+	msgReq := inbound.request
+	if msgReq.TargetMessageID == nil {
+		s.sendErrorToSender(
+			inbound.client, msgReq, pnd.ErrInvalidMessage(errors.New("target message id is required")),
+		)
+		return
+	}
+
+	var modified *chat.Message
+	var err error
+	if msgReq.MessageType == "edit" {
+		modified, err = s.chatService.EditMessage(
+			ctx, msgReq.Room.ID, *msgReq.TargetMessageID, msgReq.Sender.ID, msgReq.Message,
+		)
+	} else {
+		modified, err = s.chatService.DeleteMessage(
+			ctx, msgReq.Room.ID, *msgReq.TargetMessageID, msgReq.Sender.ID,
+		)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to modify message")
+		s.sendErrorToSender(inbound.client, msgReq, err)
+		return
+	}
+
+	recipientIDs, err := s.filterBlockedMembers(ctx, msgReq.Sender.ID, memberIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find blocked users")
+		return
+	}
+
+	s.publish(
+		ctx, msgReq.Room.ID, recipientIDs, nil,
+		NewModifiedMessageResponse(msgReq.MessageType, msgReq.MessageID, msgReq.Sender, msgReq.Room, modified),
+	)
+}
+
+// filterBlockedMembers 보낸 사용자와 서로 차단 관계인 참여자를 수신자에서 제외한다.
+// 그룹 채팅방에서도 차단한 사용자와 차단된 사용자 사이에는 메시지, 입력 중, 읽음 이벤트를 전달하지 않는다.
+func (s *WSServer) filterBlockedMembers(
+	ctx context.Context, senderID uuid.UUID, memberIDs []uuid.UUID,
+) ([]uuid.UUID, error) {
+	blockedIDs, err := s.chatService.FindBlockRelatedUserIDs(ctx, senderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(slices.Clone(memberIDs), func(id uuid.UUID) bool {
+		return slices.Contains(blockedIDs, id)
+	}), nil
+}
+
+// handleTyping 입력 중 상태는 저장하지 않고 다른 참여자에게만 전달한다.
+func (s *WSServer) handleTyping(ctx context.Context, inbound inboundMessage, memberIDs []uuid.UUID) {
+	msgReq := inbound.request
+	recipientIDs, err := s.filterBlockedMembers(ctx, msgReq.Sender.ID, memberIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find blocked users")
+		return
+	}
+
+	s.publish(
+		ctx, msgReq.Room.ID, recipientIDs, inbound.client,
+		NewTypingMessageResponse(msgReq.Sender, msgReq.Room, msgReq.IsTyping, time.Now()),
+	)
+}
+
+// handleRead 읽음 처리는 메시지를 저장하지 않고 채팅방 참여자에게 읽음 이벤트만 전달한다.
+func (s *WSServer) handleRead(ctx context.Context, inbound inboundMessage, memberIDs []uuid.UUID) {
+	msgReq := inbound.request
+	if msgReq.TargetMessageID == nil {
+		s.sendErrorToSender(
+			inbound.client, msgReq, pnd.ErrInvalidMessage(errors.New("target message id is required")),
+		)
+		return
+	}
+
+	receipt, err := s.chatService.MarkRoomAsRead(
+		ctx, msgReq.Room.ID, msgReq.Sender.ID, *msgReq.TargetMessageID,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to mark room as read")
+		s.sendErrorToSender(inbound.client, msgReq, err)
+		return
+	}
+
+	recipientIDs, err := s.filterBlockedMembers(ctx, msgReq.Sender.ID, memberIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find blocked users")
+		return
+	}
+
+	s.publish(ctx, msgReq.Room.ID, recipientIDs, nil, NewReadMessageResponse(receipt, time.Now()))
+}
+
+// PublishReadReceipt 읽음 이벤트를 채팅방 참여자에게 전달한다.
+func (s *WSServer) PublishReadReceipt(ctx context.Context, receipt *chat.ReadReceipt) error {
+	memberIDs, err := s.chatService.FindRoomMemberIDs(ctx, receipt.RoomID)
+	if err != nil {
+		return err
+	}
+	recipientIDs, err := s.filterBlockedMembers(ctx, receipt.UserID, memberIDs)
+	if err != nil {
+		return err
+	}
+
+	s.publish(ctx, receipt.RoomID, recipientIDs, nil, NewReadMessageResponse(receipt, time.Now()))
+	return nil
+}
+
+// Subscribe broker로부터 전달받은 메시지를 이 인스턴스에 연결된 클라이언트에게 전달한다.
+// 다른 인스턴스가 접속 상태를 알 수 있도록 주기적으로 heartbeat를 발행한다.
+func (s *WSServer) Subscribe(ctx context.Context) error {
+	if err := s.broker.Subscribe(ctx, s.deliver); err != nil {
+		return err
+	}
+
+	go s.loopOverPresenceHeartbeats(ctx)
+	return nil
+}
+
+// OnlineUserIDs 주어진 사용자 중 현재 어느 인스턴스에든 접속해 있는 사용자 ID 목록을 반환한다.
+func (s *WSServer) OnlineUserIDs(userIDs []uuid.UUID) []uuid.UUID {
+	now := time.Now()
+	online := make([]uuid.UUID, 0)
+	for _, userID := range userIDs {
+		if s.presence.isOnline(userID, now) {
+			online = append(online, userID)
+		}
+	}
+	return online
+}
+
+// publishPresence 사용자의 접속 상태 변경을 같은 채팅방의 다른 참여자에게 전달한다.
+func (s *WSServer) publishPresence(ctx context.Context, userID uuid.UUID, online bool) {
+	presence := Presence{UserID: userID, InstanceID: s.instanceID, Online: online}
+
+	mateIDs, err := s.chatService.FindRoomMateIDs(ctx, userID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find room mates")
+	}
+
+	payload, err := json.Marshal(NewPresenceMessageResponse(userID, online, time.Now()))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal message")
+		return
+	}
+
+	envelope := Envelope{RecipientIDs: mateIDs, Payload: payload, Presence: &presence}
+	if err := s.broker.Publish(ctx, envelope); err != nil {
+		log.Error().Err(err).Msg("Failed to publish presence")
+		s.deliver(envelope)
+	}
+}
+
+// loopOverPresenceHeartbeats 이 인스턴스에 접속한 사용자의 접속 상태를 주기적으로 발행한다.
+// heartbeat는 클라이언트에게 전달하지 않고 각 인스턴스의 presenceTracker만 갱신한다.
+func (s *WSServer) loopOverPresenceHeartbeats(ctx context.Context) {
+	ticker := time.NewTicker(presenceHeartbeatPeriod)
+	defer ticker.Stop()
+
 	for {
-		msgReq := <-s.broadcast
-
-		for _, client := range s.clients {
-			log.Info().Msg(
-				"Message from user: " +
-					client.userID.String() +
-					" to user: " + msgReq.Sender.ID.String())
-
-			// Message print
-			log.Info().Msg("Message: " + msgReq.String())
-
-			// TODO: Check if the message is for the room
-			var msg MessageResponse
-			switch msgReq.MessageType {
-			case "plain":
-				msg = NewPlainMessageResponse(
-					msgReq.MessageID,
-					msgReq.Sender,
-					msgReq.Room,
-					msgReq.Message,
-					time.Now(),
-				)
-			case "media":
-				if len(msgReq.Medias) == 0 {
-					log.Error().Msg("No media found")
-					msg = NewErrorMessageResponse(
-						msgReq.MessageID,
-						msgReq.Sender,
-						msgReq.Room,
-						"No media found",
-						time.Now(),
-					)
-				} else {
-					ids := make([]uuid.UUID, 0)
-					for _, mediaReq := range msgReq.Medias {
-						ids = append(ids, mediaReq.ID)
-					}
-					medias, err := s.mediaService.FindMediasByIDs(ctx, ids)
-					if err != nil {
-						log.Error().Err(err).Msg("Failed to find media")
-						msg = NewErrorMessageResponse(msgReq.MessageID, msgReq.Sender, msgReq.Room, "Failed to find media", time.Now())
-					} else {
-						msg = NewMediaMessageResponse(msgReq.MessageID, msgReq.Sender, msgReq.Room, medias, time.Now())
-					}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, userID := range s.clients.userIDs() {
+				envelope := Envelope{
+					Presence: &Presence{UserID: userID, InstanceID: s.instanceID, Online: true},
+				}
+				if err := s.broker.Publish(ctx, envelope); err != nil {
+					log.Error().Err(err).Msg("Failed to publish presence heartbeat")
+					s.deliver(envelope)
 				}
-			default:
-				log.Error().Msg("Unknown message type")
-				return
 			}
+		}
+	}
+}
 
-			if err := client.WriteJSON(msg); err != nil {
-				// No way but to close the connection
-				log.Error().Err(err).Msg("Failed to write message")
-				err := client.Close()
-				if err != nil {
-					log.Error().Err(err).Msg("Failed to close connection")
-					delete(s.clients, client.userID)
-					return
-				}
-				delete(s.clients, client.userID)
-				return
+// publish 메시지를 broker에 발행한다. 발행에 실패하면 이 인스턴스의 클라이언트에게만 전달한다.
+func (s *WSServer) publish(
+	ctx context.Context,
+	roomID uuid.UUID,
+	recipientIDs []uuid.UUID,
+	origin *WSClient,
+	res interface{},
+) {
+	payload, err := json.Marshal(res)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal message")
+		return
+	}
+
+	envelope := Envelope{
+		RoomID:       roomID,
+		RecipientIDs: recipientIDs,
+		Payload:      payload,
+	}
+	if origin != nil {
+		envelope.OriginClientID = origin.id
+	}
+
+	if err := s.broker.Publish(ctx, envelope); err != nil {
+		log.Error().Err(err).Msg("Failed to publish message")
+		s.deliver(envelope)
+	}
+}
+
+// deliver Envelope를 이 인스턴스에 연결된 수신자의 연결에 전달한다.
+func (s *WSServer) deliver(envelope Envelope) {
+	if envelope.Presence != nil {
+		now := time.Now()
+		s.presence.update(*envelope.Presence, now)
+
+		// 다른 인스턴스에 아직 접속해 있는 사용자는 접속 종료를 알리지 않는다.
+		if !envelope.Presence.Online && s.presence.isOnline(envelope.Presence.UserID, now) {
+			return
+		}
+	}
+	if len(envelope.Payload) == 0 {
+		return
+	}
+
+	for _, recipientID := range envelope.RecipientIDs {
+		for _, client := range s.clients.clientsOf(recipientID) {
+			if client.id == envelope.OriginClientID {
+				continue
 			}
+			s.enqueue(client, envelope.Payload)
 		}
 	}
 }
 
-type WSClient struct {
-	conn   *websocket.Conn
-	userID uuid.UUID
+// send 메시지를 클라이언트의 전송 큐에 넣는다.
+func (s *WSServer) send(client *WSClient, res interface{}) {
+	payload, err := json.Marshal(res)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal message")
+		return
+	}
+
+	s.enqueue(client, payload)
 }
 
-func NewWSClient(
-	conn *websocket.Conn,
-	userID uuid.UUID,
-) WSClient {
-	return WSClient{conn, userID}
+// enqueue 큐가 가득 찬 느린 클라이언트는 다른 사용자에게 영향을 주지 않도록 연결을 끊는다.
+func (s *WSServer) enqueue(client *WSClient, payload []byte) {
+	if !client.Send(payload) {
+		log.Warn().Str("userID", client.userID.String()).Msg("Evicting slow client")
+		s.removeClient(client)
+	}
 }
 
-func (c *WSClient) WriteJSON(v interface{}) error {
-	return c.conn.WriteJSON(v)
+// sendErrorToSender 메시지를 보낸 연결에만 에러 코드와 메시지를 전달한다.
+func (s *WSServer) sendErrorToSender(client *WSClient, msgReq MessageRequest, err error) {
+	appErr := toAppError(err)
+	errMsg := NewErrorMessageResponse(
+		msgReq.MessageID,
+		msgReq.Sender,
+		msgReq.Room,
+		appErr.Code,
+		appErr.Message,
+		time.Now(),
+	)
+	s.send(client, errMsg)
 }
 
-func (c *WSClient) Close() error {
-	return c.conn.Close()
+// toAppError 클라이언트에 내부 오류 내용이 그대로 노출되지 않도록 AppError로 변환한다.
+func toAppError(err error) *pnd.AppError {
+	var appErr *pnd.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	if pgErr := pnd.FromPostgresError(err); pgErr != nil {
+		return pgErr
+	}
+	return pnd.NewAppError(err, http.StatusInternalServerError, pnd.ErrCodeUnknown, "알 수 없는 오류가 발생했습니다")
+}
+
+// inboundMessage 클라이언트로부터 받은 메시지와 그 메시지를 보낸 연결
+type inboundMessage struct {
+	client  *WSClient
+	request MessageRequest
 }
 
 type MediaRequest struct {
@@ -203,24 +623,41 @@ type MessageRequest struct {
 	MessageType string         `json:"messageType"`
 	Medias      []MediaRequest `json:"medias,omitempty"`
 	Message     string         `json:"message"`
+	// 읽음 처리 등 다른 메시지를 대상으로 하는 요청에서 사용한다.
+	TargetMessageID *uuid.UUID `json:"targetMessageId,omitempty"`
+	// typing 요청에서 입력 중인지 여부
+	IsTyping bool `json:"isTyping,omitempty"`
+	// 답장하는 메시지의 ID
+	ReplyToID *uuid.UUID `json:"replyToId,omitempty"`
+}
+
+func (m MessageRequest) mediaIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(m.Medias))
+	for _, mediaReq := range m.Medias {
+		ids = append(ids, mediaReq.ID)
+	}
+	return ids
 }
 
-func (m MessageRequest) String() string {
-	return "Sender: " + m.Sender.ID.String() +
-		" Room: " + m.Room.ID.String() +
-		" MessageID: " + m.MessageID +
-		" MessageType: " + m.MessageType +
-		" Message: " + m.Message +
-		" Medias: " + strconv.Itoa(len(m.Medias))
+func (m MessageRequest) replyToID() uuid.NullUUID {
+	if m.ReplyToID == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *m.ReplyToID, Valid: true}
 }
 
 type MessageResponse struct {
+	ID          *uuid.UUID         `json:"id,omitempty"`
 	Sender      Sender             `json:"sender"`
 	Room        Room               `json:"room"`
 	MessageID   string             `json:"messageId"`
 	MessageType string             `json:"messageType"`
 	Medias      []media.DetailView `json:"medias,omitempty"`
 	Message     string             `json:"message"`
+	Code        pnd.AppErrorCode   `json:"code,omitempty"`
+	ReplyToID   *uuid.UUID         `json:"replyToId,omitempty"`
+	IsTyping    *bool              `json:"isTyping,omitempty"`
+	Online      *bool              `json:"online,omitempty"`
 	CreatedAt   string             `json:"createdAt"`
 	UpdatedAt   string             `json:"updatedAt"`
 }
@@ -240,6 +677,7 @@ type Media struct {
 }
 
 func NewPlainMessageResponse(
+	id uuid.UUID,
 	messageID string,
 	sender Sender,
 	room Room,
@@ -247,6 +685,7 @@ func NewPlainMessageResponse(
 	now time.Time,
 ) MessageResponse {
 	return MessageResponse{
+		ID:          &id,
 		MessageID:   messageID,
 		Sender:      sender,
 		Room:        room,
@@ -261,6 +700,7 @@ func NewErrorMessageResponse(
 	messageID string,
 	sender Sender,
 	room Room,
+	code pnd.AppErrorCode,
 	message string,
 	now time.Time,
 ) MessageResponse {
@@ -269,6 +709,7 @@ func NewErrorMessageResponse(
 		Sender:      sender,
 		Room:        room,
 		MessageType: "error",
+		Code:        code,
 		Message:     message,
 		CreatedAt:   now.Format(time.RFC3339),
 		UpdatedAt:   now.Format(time.RFC3339),
@@ -276,6 +717,7 @@ func NewErrorMessageResponse(
 }
 
 func NewMediaMessageResponse(
+	id uuid.UUID,
 	messageID string,
 	sender Sender,
 	room Room,
@@ -283,6 +725,7 @@ func NewMediaMessageResponse(
 	now time.Time,
 ) MessageResponse {
 	return MessageResponse{
+		ID:          &id,
 		MessageID:   messageID,
 		Sender:      sender,
 		Room:        room,
@@ -292,3 +735,89 @@ func NewMediaMessageResponse(
 		UpdatedAt:   now.Format(time.RFC3339),
 	}
 }
+
+func NewAckMessageResponse(
+	id uuid.UUID,
+	messageID string,
+	sender Sender,
+	room Room,
+	now time.Time,
+) MessageResponse {
+	return MessageResponse{
+		ID:          &id,
+		MessageID:   messageID,
+		Sender:      sender,
+		Room:        room,
+		MessageType: "ack",
+		CreatedAt:   now.Format(time.RFC3339),
+		UpdatedAt:   now.Format(time.RFC3339),
+	}
+}
+
+// NewReadMessageResponse 읽음 이벤트. ID는 사용자가 마지막으로 읽은 메시지의 ID이다.
+func NewReadMessageResponse(
+	receipt *chat.ReadReceipt,
+	now time.Time,
+) MessageResponse {
+	return MessageResponse{
+		ID:          &receipt.LastReadMessageID,
+		Sender:      Sender{ID: receipt.UserID},
+		Room:        Room{ID: receipt.RoomID},
+		MessageType: "read",
+		CreatedAt:   now.Format(time.RFC3339),
+		UpdatedAt:   now.Format(time.RFC3339),
+	}
+}
+
+// NewTypingMessageResponse 입력 중 이벤트. 저장되지 않는다.
+func NewTypingMessageResponse(
+	sender Sender,
+	room Room,
+	isTyping bool,
+	now time.Time,
+) MessageResponse {
+	return MessageResponse{
+		Sender:      sender,
+		Room:        room,
+		MessageType: "typing",
+		IsTyping:    &isTyping,
+		CreatedAt:   now.Format(time.RFC3339),
+		UpdatedAt:   now.Format(time.RFC3339),
+	}
+}
+
+// NewPresenceMessageResponse 접속 상태 이벤트. 특정 채팅방에 속하지 않는다.
+func NewPresenceMessageResponse(
+	userID uuid.UUID,
+	online bool,
+	now time.Time,
+) MessageResponse {
+	return MessageResponse{
+		Sender:      Sender{ID: userID},
+		MessageType: "presence",
+		Online:      &online,
+		CreatedAt:   now.Format(time.RFC3339),
+		UpdatedAt:   now.Format(time.RFC3339),
+	}
+}
+
+// NewModifiedMessageResponse 메시지 수정(edit)/삭제(delete) 이벤트. ID는 수정/삭제된 메시지의 ID이다.
+func NewModifiedMessageResponse(
+	messageType string,
+	messageID string,
+	sender Sender,
+	room Room,
+	modified *chat.Message,
+) MessageResponse {
+	return MessageResponse{
+		ID:          &modified.ID,
+		MessageID:   messageID,
+		Sender:      sender,
+		Room:        room,
+		MessageType: messageType,
+		Message:     modified.Content,
+		ReplyToID:   modified.ReplyToID,
+		CreatedAt:   modified.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   modified.UpdatedAt.Format(time.RFC3339),
+	}
+}
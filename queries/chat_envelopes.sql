@@ -0,0 +1,17 @@
+-- name: CreateChatEnvelope :exec
+INSERT INTO chat_envelopes
+(id,
+ envelope,
+ created_at)
+VALUES ($1, $2, NOW());
+
+-- name: FindChatEnvelope :one
+SELECT envelope
+FROM chat_envelopes
+WHERE id = $1;
+
+-- name: DeleteExpiredChatEnvelopes :exec
+DELETE
+FROM chat_envelopes
+-- 구독하는 인스턴스는 NOTIFY를 받은 즉시 읽으므로 오래된 Envelope는 지운다.
+WHERE created_at < NOW() - INTERVAL '5 minutes';
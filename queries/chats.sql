@@ -1,3 +1,16 @@
+-- name: CreateDirectRoom :one
+INSERT INTO chat_rooms
+(id,
+ name,
+ room_type,
+ sos_post_id,
+ direct_key,
+ created_at,
+ updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+ON CONFLICT (direct_key) WHERE deleted_at IS NULL DO NOTHING
+RETURNING id, name, room_type, sos_post_id, created_at, updated_at;
+
 -- name: CreateRoom :one
 INSERT INTO chat_rooms
 (id,
@@ -18,15 +31,32 @@ WHERE id = $1;
 SELECT EXISTS (SELECT 1
                FROM user_chat_rooms
                WHERE room_id = $1
-                 AND user_id = $2);
+                 AND user_id = $2
+                 AND left_at IS NULL);
 
--- name: FindRoomByIDAndUserID :one
+-- name: FindRoomByDirectKey :one
 SELECT id,
        name,
        room_type,
+       sos_post_id,
        created_at,
        updated_at
 FROM chat_rooms
+WHERE direct_key = $1
+  AND deleted_at IS NULL;
+
+-- name: FindRoomByIDAndUserID :one
+SELECT chat_rooms.id,
+       chat_rooms.name,
+       chat_rooms.room_type,
+       chat_rooms.sos_post_id,
+       chat_rooms.image_id,
+       media.url AS image_url,
+       chat_rooms.created_at,
+       chat_rooms.updated_at
+FROM chat_rooms
+         LEFT OUTER JOIN media
+                         ON chat_rooms.image_id = media.id
 WHERE chat_rooms.deleted_at IS NULL
   AND (chat_rooms.id = $1)
   AND EXISTS (SELECT 1
@@ -35,6 +65,50 @@ WHERE chat_rooms.deleted_at IS NULL
                 AND room_id = chat_rooms.id
                 AND left_at IS NULL);
 
+-- name: FindRoomMemberRole :one
+SELECT role
+FROM user_chat_rooms
+WHERE room_id = $1
+  AND user_id = $2
+  AND left_at IS NULL;
+
+-- name: FindRoomTypeByID :one
+SELECT room_type
+FROM chat_rooms
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: FindRoomMembers :many
+SELECT users.id,
+       users.nickname,
+       media.url AS profile_image_url,
+       user_chat_rooms.role,
+       user_chat_rooms.joined_at
+FROM user_chat_rooms
+         JOIN users
+              ON users.id = user_chat_rooms.user_id
+         LEFT OUTER JOIN media
+                         ON users.profile_image_id = media.id
+WHERE user_chat_rooms.room_id = $1
+  AND user_chat_rooms.left_at IS NULL
+ORDER BY user_chat_rooms.joined_at, user_chat_rooms.id;
+
+-- name: FindRoomMateIDsByUserID :many
+SELECT DISTINCT others.user_id
+FROM user_chat_rooms mine
+         JOIN user_chat_rooms others
+              ON others.room_id = mine.room_id
+WHERE mine.user_id = $1
+  AND mine.left_at IS NULL
+  AND others.left_at IS NULL
+  AND others.user_id <> mine.user_id;
+
+-- name: FindUserIDsByRoomID :many
+SELECT user_id
+FROM user_chat_rooms
+WHERE room_id = $1
+  AND left_at IS NULL;
+
 -- name: FindAllUserChatRoomsByUserUID :many
 SELECT user_chat_rooms.id,
        user_chat_rooms.user_id,
@@ -52,7 +126,30 @@ SELECT user_chat_rooms.id,
        chat_rooms.name       AS chat_room_name,
        chat_rooms.room_type  AS chat_room_type,
        chat_rooms.created_at AS chat_room_created_at,
-       chat_rooms.updated_at AS chat_room_updated_at
+       chat_rooms.updated_at AS chat_room_updated_at,
+       user_chat_rooms.last_read_message_id,
+       chat_rooms.sos_post_id AS chat_room_sos_post_id,
+       chat_room_image.url    AS chat_room_image_url,
+       (SELECT COUNT(*)
+        FROM chat_messages unread
+        WHERE unread.room_id = chat_rooms.id
+          AND unread.deleted_at IS NULL
+          AND unread.user_id <> user_chat_rooms.user_id
+          AND (user_chat_rooms.last_read_message_id IS NULL
+            OR unread.id > user_chat_rooms.last_read_message_id)
+          -- 차단 관계인 사용자의 메시지는 메시지 목록과 마찬가지로 제외한다.
+          AND NOT EXISTS (SELECT 1
+                          FROM user_blocks
+                          WHERE (user_blocks.blocker_id = user_chat_rooms.user_id
+                                 AND user_blocks.blocked_id = unread.user_id)
+                             OR (user_blocks.blocker_id = unread.user_id
+                                 AND user_blocks.blocked_id = user_chat_rooms.user_id))
+       )                     AS unread_count,
+       last_message.id           AS last_message_id,
+       last_message.user_id      AS last_message_user_id,
+       last_message.message_type AS last_message_type,
+       last_message.content      AS last_message_content,
+       last_message.created_at   AS last_message_created_at
 FROM user_chat_rooms
          JOIN users
               ON users.id = user_chat_rooms.user_id
@@ -60,18 +157,38 @@ FROM user_chat_rooms
               ON chat_rooms.id = user_chat_rooms.room_id
          LEFT OUTER JOIN media
                          ON users.profile_image_id = media.id
+         LEFT OUTER JOIN media chat_room_image
+                         ON chat_rooms.image_id = chat_room_image.id
+         LEFT JOIN LATERAL (SELECT id,
+                                   user_id,
+                                   message_type,
+                                   content,
+                                   created_at
+                            FROM chat_messages
+                            WHERE chat_messages.room_id = chat_rooms.id
+                              AND chat_messages.deleted_at IS NULL
+                              AND NOT EXISTS (SELECT 1
+                                              FROM user_blocks
+                                              WHERE (user_blocks.blocker_id = user_chat_rooms.user_id
+                                                     AND user_blocks.blocked_id = chat_messages.user_id)
+                                                 OR (user_blocks.blocker_id = chat_messages.user_id
+                                                     AND user_blocks.blocked_id = user_chat_rooms.user_id))
+                            ORDER BY chat_messages.created_at DESC
+                            LIMIT 1) last_message ON TRUE
 WHERE user_chat_rooms.left_at IS NULL
   AND chat_rooms.deleted_at IS NULL
-  AND user_chat_rooms.user_id = $1;
+  AND user_chat_rooms.user_id = $1
+ORDER BY COALESCE(last_message.created_at, chat_rooms.created_at) DESC;
 
 -- name: JoinRoom :one
 INSERT INTO user_chat_rooms
 (id,
  user_id,
  room_id,
+ role,
  joined_at)
-VALUES ($1, $2, $3, NOW())
-RETURNING id, user_id, room_id, joined_at;
+VALUES ($1, $2, $3, $4, NOW())
+RETURNING id, user_id, room_id, role, joined_at;
 
 
 -- name: JoinRooms :exec
@@ -90,6 +207,90 @@ SET left_at = NOW()
 WHERE user_id = $1
   AND room_id = $2;
 
+-- name: LeaveAllRoomMembers :exec
+UPDATE
+    user_chat_rooms
+SET left_at = NOW()
+WHERE room_id = $1
+  AND left_at IS NULL;
+
+-- name: PromoteNextRoomOwner :exec
+UPDATE
+    user_chat_rooms
+SET role = 'owner'
+WHERE id = (SELECT candidates.id
+            FROM user_chat_rooms candidates
+            WHERE candidates.room_id = $1
+              AND candidates.left_at IS NULL
+            -- 관리자, 먼저 참여한 사용자 순으로 다음 방장을 지정한다.
+            ORDER BY candidates.role = 'admin' DESC, candidates.joined_at, candidates.id
+            LIMIT 1);
+
+-- name: SearchMessagesByRoomID :many
+SELECT id,
+       user_id,
+       room_id,
+       message_type,
+       content,
+       reply_to_id,
+       created_at,
+       updated_at
+FROM chat_messages
+WHERE room_id = sqlc.arg('room_id')
+  AND deleted_at IS NULL
+  AND content ILIKE '%' || sqlc.arg('keyword')::text || '%'
+  AND (sqlc.narg('prev')::uuid IS NULL OR id < sqlc.narg('prev')::uuid)
+  AND (sqlc.narg('next')::uuid IS NULL OR id > sqlc.narg('next')::uuid)
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = sqlc.arg('viewer_id')
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = sqlc.arg('viewer_id')))
+-- next만 주어진 경우에는 next와 가까운 메시지부터 조회하도록 오래된 순으로 정렬한다.
+ORDER BY CASE WHEN sqlc.narg('prev')::uuid IS NULL AND sqlc.narg('next')::uuid IS NOT NULL THEN id END,
+         id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateLastReadMessage :one
+UPDATE
+    user_chat_rooms
+SET last_read_message_id = GREATEST(last_read_message_id, sqlc.arg('message_id')::uuid)
+WHERE user_chat_rooms.room_id = sqlc.arg('room_id')
+  AND user_chat_rooms.user_id = sqlc.arg('user_id')
+  AND user_chat_rooms.left_at IS NULL
+  AND EXISTS (SELECT 1
+              FROM chat_messages
+              WHERE chat_messages.id = sqlc.arg('message_id')::uuid
+                AND chat_messages.room_id = sqlc.arg('room_id')
+                AND chat_messages.deleted_at IS NULL)
+RETURNING user_id, room_id, last_read_message_id;
+
+-- name: UpdateRoomInfo :exec
+UPDATE
+    chat_rooms
+SET name       = CASE WHEN sqlc.arg('set_name')::boolean THEN sqlc.arg('name')::text ELSE name END,
+    image_id   = CASE WHEN sqlc.arg('set_image')::boolean THEN sqlc.narg('image_id')::uuid ELSE image_id END,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL;
+
+-- name: UpdateRoomMemberRole :exec
+UPDATE
+    user_chat_rooms
+SET role = $3
+WHERE room_id = $1
+  AND user_id = $2
+  AND left_at IS NULL;
+
+-- name: UpdateRoomSOSPost :exec
+UPDATE
+    chat_rooms
+SET sos_post_id = $2,
+    updated_at  = NOW()
+WHERE id = $1;
+
 -- name: UserExistsInRoom :one
 SELECT EXISTS (SELECT 1
                FROM user_chat_rooms
@@ -105,19 +306,45 @@ SELECT EXISTS (SELECT 1
     );
 
 
+-- name: WriteMessage :one
+INSERT INTO chat_messages
+(id,
+ user_id,
+ room_id,
+ message_type,
+ content,
+ reply_to_id,
+ client_message_id,
+ created_at,
+ updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+-- 같은 사용자가 같은 client_message_id로 재전송하면 저장하지 않는다.
+ON CONFLICT (user_id, client_message_id) DO NOTHING
+RETURNING id, user_id, room_id, message_type, content, reply_to_id, created_at, updated_at;
+
 -- name: FindPrevMessageByRoomID :many
 SELECT id,
        user_id,
        room_id,
        message_type,
-       content,
-       created_at
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE room_id = $2
   AND (
     id < sqlc.narg('prev')::uuid
     )
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = sqlc.arg('viewer_id')
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = sqlc.arg('viewer_id')))
 ORDER BY chat_messages.created_at DESC
 LIMIT $1;
 
@@ -126,14 +353,24 @@ SELECT id,
        user_id,
        room_id,
        message_type,
-       content,
-       created_at
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE room_id = $2
   AND (
     id > sqlc.narg('next')::uuid
     )
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = sqlc.arg('viewer_id')
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = sqlc.arg('viewer_id')))
 ORDER BY chat_messages.created_at ASC
 LIMIT $1;
 
@@ -142,13 +379,23 @@ SELECT id,
        user_id,
        room_id,
        message_type,
-       content,
-       created_at
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE room_id = $2
   AND id > sqlc.narg('prev')::uuid
   AND id < sqlc.narg('next')::uuid
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = sqlc.arg('viewer_id')
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = sqlc.arg('viewer_id')))
 ORDER BY chat_messages.created_at ASC
 LIMIT $1;
 
@@ -156,9 +403,15 @@ LIMIT $1;
 SELECT EXISTS (
     SELECT 1
     FROM chat_messages
-    WHERE chat_messages.deleted_at IS NULL
-      AND room_id = $2
+    WHERE room_id = $2
       AND id < $1  -- 주어진 prev UUID보다 이전 메시지
+      -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+      AND NOT EXISTS (SELECT 1
+                      FROM user_blocks
+                      WHERE (user_blocks.blocker_id = sqlc.arg('viewer_id')
+                             AND user_blocks.blocked_id = chat_messages.user_id)
+                         OR (user_blocks.blocker_id = chat_messages.user_id
+                             AND user_blocks.blocked_id = sqlc.arg('viewer_id')))
     LIMIT 1
 );
 
@@ -166,9 +419,15 @@ SELECT EXISTS (
 SELECT EXISTS (
     SELECT 1
     FROM chat_messages
-    WHERE chat_messages.deleted_at IS NULL
-      AND room_id = $2
+    WHERE room_id = $2
       AND id > $1  -- 주어진 next UUID보다 이후 메시지
+      -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+      AND NOT EXISTS (SELECT 1
+                      FROM user_blocks
+                      WHERE (user_blocks.blocker_id = sqlc.arg('viewer_id')
+                             AND user_blocks.blocked_id = chat_messages.user_id)
+                         OR (user_blocks.blocker_id = chat_messages.user_id
+                             AND user_blocks.blocked_id = sqlc.arg('viewer_id')))
     LIMIT 1
 );
 
@@ -177,10 +436,64 @@ SELECT id,
        user_id,
        room_id,
        message_type,
-       content,
-       created_at
+       -- 삭제된 메시지는 내용 없이 반환한다.
+       CASE WHEN deleted_at IS NULL THEN content ELSE '' END AS content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at
 FROM chat_messages
-WHERE chat_messages.deleted_at IS NULL
-  AND room_id = $2
+WHERE room_id = $2
+  -- 조회하는 사용자가 차단했거나 조회하는 사용자를 차단한 사용자의 메시지는 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE (user_blocks.blocker_id = sqlc.arg('viewer_id')
+                         AND user_blocks.blocked_id = chat_messages.user_id)
+                     OR (user_blocks.blocker_id = chat_messages.user_id
+                         AND user_blocks.blocked_id = sqlc.arg('viewer_id')))
 ORDER BY chat_messages.created_at DESC
 LIMIT $1;
+
+-- name: FindMessageByID :one
+SELECT id,
+       user_id,
+       room_id,
+       message_type,
+       content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at,
+       client_message_id
+FROM chat_messages
+WHERE id = $1;
+
+-- name: FindMessageByClientMessageID :one
+SELECT id,
+       user_id,
+       room_id,
+       message_type,
+       content,
+       reply_to_id,
+       created_at,
+       updated_at,
+       deleted_at,
+       client_message_id
+FROM chat_messages
+WHERE user_id = $1
+  AND client_message_id = $2;
+
+-- name: UpdateMessageContent :one
+UPDATE
+    chat_messages
+SET content    = $2,
+    updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+RETURNING id, user_id, room_id, message_type, content, reply_to_id, created_at, updated_at;
+
+-- name: DeleteMessage :exec
+UPDATE
+    chat_messages
+SET deleted_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,31 @@
+-- name: UpsertDeviceToken :one
+INSERT INTO user_device_tokens
+(id,
+ user_id,
+ token,
+ platform,
+ created_at,
+ updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW())
+ON CONFLICT (token) DO UPDATE SET user_id    = EXCLUDED.user_id,
+                                  platform   = EXCLUDED.platform,
+                                  updated_at = NOW()
+RETURNING id, user_id, token, platform, created_at, updated_at;
+
+-- name: DeleteDeviceToken :exec
+DELETE
+FROM user_device_tokens
+WHERE user_id = $1
+  AND token = $2;
+
+-- name: DeleteDeviceTokens :exec
+DELETE
+FROM user_device_tokens
+WHERE token = ANY (sqlc.arg('tokens')::varchar[]);
+
+-- name: FindDeviceTokensByUserIDs :many
+SELECT user_id,
+       token
+FROM user_device_tokens
+WHERE user_id = ANY (sqlc.arg('user_ids')::uuid[])
+ORDER BY user_id;
@@ -3,11 +3,19 @@ INSERT INTO media
 (id,
  media_type,
  url,
+ uploader_id,
  created_at,
  updated_at)
-VALUES ($1, $2, $3, NOW(), NOW())
+VALUES ($1, $2, $3, $4, NOW(), NOW())
 RETURNING id, media_type, url, created_at, updated_at;
 
+-- name: CountMediasByUploader :one
+SELECT COUNT(*)
+FROM media
+WHERE id = ANY (sqlc.arg('ids')::uuid[])
+  AND uploader_id = sqlc.arg('uploader_id')
+  AND deleted_at IS NULL;
+
 -- name: FindSingleMedia :one
 SELECT id,
        media_type,
@@ -0,0 +1,13 @@
+-- name: CreateReport :one
+INSERT INTO reports
+(id,
+ reporter_id,
+ reported_user_id,
+ target_type,
+ target_id,
+ reason,
+ description,
+ created_at,
+ updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+RETURNING id, reporter_id, reported_user_id, target_type, target_id, reason, description, status, created_at, updated_at;
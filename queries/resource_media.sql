@@ -25,6 +25,24 @@ WHERE (rm.resource_id = sqlc.narg('resource_id') OR sqlc.narg('resource_id') IS
   AND (sqlc.arg('include_deleted')::BOOLEAN = TRUE OR
        (sqlc.arg('include_deleted')::BOOLEAN = FALSE AND rm.deleted_at IS NULL));
 
+-- name: FindResourceMediaByResourceIDs :many
+SELECT rm.resource_id,
+       m.id AS media_id,
+       m.media_type,
+       m.url,
+       m.created_at,
+       m.updated_at
+FROM resource_media rm
+         INNER JOIN
+     media m
+     ON
+         rm.media_id = m.id
+WHERE rm.resource_id = ANY (sqlc.arg('resource_ids')::uuid[])
+  AND rm.resource_type = sqlc.arg('resource_type')
+  AND rm.deleted_at IS NULL
+  AND m.deleted_at IS NULL
+ORDER BY rm.created_at;
+
 -- name: DeleteResourceMediaByResourceID :exec
 UPDATE
     resource_media
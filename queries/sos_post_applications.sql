@@ -0,0 +1,54 @@
+-- name: CreateSOSPostApplication :one
+INSERT INTO sos_post_applications
+(id,
+ sos_post_id,
+ applicant_id,
+ message,
+ created_at,
+ updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW())
+RETURNING id, sos_post_id, applicant_id, message, status, created_at, updated_at;
+
+-- name: FindSOSPostApplicationByID :one
+SELECT id,
+       sos_post_id,
+       applicant_id,
+       message,
+       status,
+       created_at,
+       updated_at
+FROM sos_post_applications
+WHERE id = $1;
+
+-- name: FindSOSPostApplicationsBySOSPostID :many
+SELECT sos_post_applications.id,
+       sos_post_applications.sos_post_id,
+       sos_post_applications.applicant_id,
+       users.nickname,
+       media.url AS profile_image_url,
+       sos_post_applications.message,
+       sos_post_applications.status,
+       sos_post_applications.created_at,
+       sos_post_applications.updated_at
+FROM sos_post_applications
+         INNER JOIN users ON sos_post_applications.applicant_id = users.id
+         LEFT OUTER JOIN media ON users.profile_image_id = media.id
+WHERE sos_post_applications.sos_post_id = $1
+ORDER BY sos_post_applications.created_at;
+
+-- name: RejectPendingSOSPostApplications :exec
+UPDATE sos_post_applications
+SET status     = 'rejected',
+    updated_at = NOW()
+WHERE sos_post_id = $1
+  AND id <> $2
+  AND status = 'pending';
+
+-- name: UpdateSOSPostApplicationStatus :one
+UPDATE sos_post_applications
+SET status     = $1,
+    updated_at = NOW()
+WHERE id = $2
+  -- 대기 중인 지원만 수락하거나 거절할 수 있다.
+  AND status = 'pending'
+RETURNING id, sos_post_id, applicant_id, message, status, created_at, updated_at;
@@ -0,0 +1,60 @@
+-- name: BookmarkSOSPost :exec
+INSERT INTO sos_post_bookmarks
+(id,
+ user_id,
+ sos_post_id,
+ created_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (user_id, sos_post_id) DO NOTHING;
+
+-- name: UnbookmarkSOSPost :exec
+DELETE
+FROM sos_post_bookmarks
+WHERE user_id = $1
+  AND sos_post_id = $2;
+
+-- name: FindBookmarkedSOSPostIDs :many
+SELECT sos_post_id
+FROM sos_post_bookmarks
+WHERE user_id = sqlc.arg('user_id')
+  AND sos_post_id = ANY (sqlc.arg('sos_post_ids')::uuid[]);
+
+-- name: FindBookmarkedSOSPosts :many
+SELECT v_sos_posts.id,
+       v_sos_posts.title,
+       v_sos_posts.content,
+       v_sos_posts.reward,
+       v_sos_posts.reward_type,
+       v_sos_posts.reward_amount,
+       v_sos_posts.reward_unit,
+       v_sos_posts.gifticon_description,
+       v_sos_posts.care_type,
+       v_sos_posts.carer_gender,
+       v_sos_posts.thumbnail_id,
+       v_sos_posts.author_id,
+       v_sos_posts.created_at,
+       v_sos_posts.updated_at,
+       v_sos_posts.status,
+       v_sos_posts.region,
+       v_sos_posts.latitude,
+       v_sos_posts.longitude,
+       v_sos_posts.view_count,
+       v_sos_posts.application_count,
+       v_sos_posts.bookmark_count,
+       v_sos_posts.dates,
+       v_pets_for_sos_posts.pets_info,
+       v_media_for_sos_posts.media_info,
+       v_conditions.conditions_info
+FROM sos_post_bookmarks
+         INNER JOIN v_sos_posts ON sos_post_bookmarks.sos_post_id = v_sos_posts.id
+         LEFT JOIN v_pets_for_sos_posts ON v_sos_posts.id = v_pets_for_sos_posts.sos_post_id
+         LEFT JOIN v_media_for_sos_posts ON v_sos_posts.id = v_media_for_sos_posts.sos_post_id
+         LEFT JOIN v_conditions ON v_sos_posts.id = v_conditions.sos_post_id
+WHERE sos_post_bookmarks.user_id = sqlc.arg('user_id')
+  -- 저장한 뒤 차단한 사용자의 게시글은 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE user_blocks.blocker_id = sqlc.arg('user_id')
+                    AND user_blocks.blocked_id = v_sos_posts.author_id)
+ORDER BY sos_post_bookmarks.created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
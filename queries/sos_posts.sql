@@ -9,19 +9,29 @@ INSERT INTO sos_posts
  carer_gender,
  reward_type,
  thumbnail_id,
+ region,
+ latitude,
+ longitude,
+ reward_amount,
+ reward_unit,
+ gifticon_description,
  created_at,
  updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
-RETURNING id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at;
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NOW(), NOW())
+RETURNING id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at,
+    status, region, latitude, longitude, reward_amount, reward_unit, gifticon_description;
 
 -- name: InsertSOSDate :one
 INSERT INTO sos_dates
 (id,
  date_start_at,
  date_end_at,
+ time_slots,
+ timezone,
+ start_at,
  created_at,
  updated_at)
-VALUES ($1, $2, $3, NOW(), NOW())
+VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
 RETURNING id, date_start_at, date_end_at, created_at, updated_at;
 
 -- name: LinkSOSPostDate :exec
@@ -67,12 +77,22 @@ SELECT v_sos_posts.id,
        v_sos_posts.content,
        v_sos_posts.reward,
        v_sos_posts.reward_type,
+       v_sos_posts.reward_amount,
+       v_sos_posts.reward_unit,
+       v_sos_posts.gifticon_description,
        v_sos_posts.care_type,
        v_sos_posts.carer_gender,
        v_sos_posts.thumbnail_id,
        v_sos_posts.author_id,
        v_sos_posts.created_at,
        v_sos_posts.updated_at,
+       v_sos_posts.status,
+       v_sos_posts.region,
+       v_sos_posts.latitude,
+       v_sos_posts.longitude,
+       v_sos_posts.view_count,
+       v_sos_posts.application_count,
+       v_sos_posts.bookmark_count,
        v_sos_posts.dates,
        v_pets_for_sos_posts.pets_info,
        v_media_for_sos_posts.media_info,
@@ -81,13 +101,104 @@ FROM v_sos_posts
          LEFT JOIN v_pets_for_sos_posts ON v_sos_posts.id = v_pets_for_sos_posts.sos_post_id
          LEFT JOIN v_media_for_sos_posts ON v_sos_posts.id = v_media_for_sos_posts.sos_post_id
          LEFT JOIN v_conditions ON v_sos_posts.id = v_conditions.sos_post_id
-WHERE v_sos_posts.earliest_date_start_at >= sqlc.narg('earliest_date_start_at')
+WHERE (v_sos_posts.earliest_date_start_at >= sqlc.narg('earliest_date_start_at') OR sqlc.arg('include_past')::boolean)
   AND (sqlc.narg('pet_type') = 'all' OR NOT EXISTS
     (SELECT 1
      FROM unnest(pet_type_list) AS pet_type
      WHERE pet_type <> sqlc.narg('pet_type')))
+  -- 조회하는 사용자가 차단한 사용자의 게시글은 제외한다.
+  AND NOT EXISTS (SELECT 1
+                  FROM user_blocks
+                  WHERE user_blocks.blocker_id = sqlc.narg('viewer_id')
+                    AND user_blocks.blocked_id = v_sos_posts.author_id)
+  AND (sqlc.narg('status')::text IS NULL OR v_sos_posts.status = sqlc.narg('status')::text)
+  -- 행정구역이 주어지면 해당 지역과 하위 지역의 게시글만 조회한다.
+  AND (sqlc.narg('region')::text IS NULL OR starts_with(v_sos_posts.region, sqlc.narg('region')::text))
+  -- 반경이 주어지면 기준 좌표에서 반경 안에 있는 게시글만 조회한다.
+  AND (sqlc.narg('radius_km')::float8 IS NULL OR
+       distance_km(
+           sqlc.narg('latitude')::float8, sqlc.narg('longitude')::float8,
+           v_sos_posts.latitude, v_sos_posts.longitude
+       ) <= sqlc.narg('radius_km')::float8)
+  AND (sqlc.narg('care_type')::text IS NULL OR v_sos_posts.care_type = sqlc.narg('care_type')::text)
+  AND (sqlc.narg('reward_type')::text IS NULL OR v_sos_posts.reward_type = sqlc.narg('reward_type')::text)
+  -- 사례 금액 범위가 주어지면 금액이 정해진 게시글 중 범위 안에 있는 게시글만 조회한다.
+  AND (sqlc.narg('reward_unit')::text IS NULL OR v_sos_posts.reward_unit = sqlc.narg('reward_unit')::text)
+  AND (sqlc.narg('min_reward_amount')::int IS NULL OR
+       v_sos_posts.reward_amount >= sqlc.narg('min_reward_amount')::int)
+  AND (sqlc.narg('max_reward_amount')::int IS NULL OR
+       v_sos_posts.reward_amount <= sqlc.narg('max_reward_amount')::int)
+  -- 돌보미 성별이 주어지면 해당 성별 또는 성별 무관인 게시글을 조회한다.
+  AND (sqlc.narg('carer_gender')::text IS NULL OR
+       v_sos_posts.carer_gender IN (sqlc.narg('carer_gender')::text, 'all'))
+  -- 주어진 돌봄 조건을 모두 포함하는 게시글만 조회한다.
+  AND (sqlc.narg('condition_ids')::uuid[] IS NULL OR NOT EXISTS
+    (SELECT 1
+     FROM unnest(sqlc.narg('condition_ids')::uuid[]) AS condition_id
+     WHERE condition_id NOT IN (SELECT sos_posts_conditions.sos_condition_id
+                                FROM sos_posts_conditions
+                                WHERE sos_posts_conditions.sos_post_id = v_sos_posts.id
+                                  AND sos_posts_conditions.deleted_at IS NULL)))
+  -- 돌봄 날짜 중 하나라도 주어진 기간, 시간대와 겹치는 게시글만 조회한다.
+  -- 돌봄 시간대가 없는 날짜는 하루 종일 돌봄이 필요하므로 모든 시간대와 겹친다.
+  AND ((sqlc.narg('date_from')::date IS NULL AND sqlc.narg('date_to')::date IS NULL AND
+        sqlc.narg('time_from')::text IS NULL AND sqlc.narg('time_to')::text IS NULL) OR EXISTS
+    (SELECT 1
+     FROM sos_posts_dates
+              INNER JOIN sos_dates ON sos_posts_dates.sos_dates_id = sos_dates.id
+     WHERE sos_posts_dates.sos_post_id = v_sos_posts.id
+       AND sos_posts_dates.deleted_at IS NULL
+       AND sos_dates.deleted_at IS NULL
+       AND (sqlc.narg('date_to')::date IS NULL OR sos_dates.date_start_at <= sqlc.narg('date_to')::date)
+       AND (sqlc.narg('date_from')::date IS NULL OR sos_dates.date_end_at >= sqlc.narg('date_from')::date)
+       AND (jsonb_array_length(sos_dates.time_slots) = 0 OR EXISTS
+         (SELECT 1
+          FROM jsonb_array_elements(sos_dates.time_slots) AS time_slot
+          WHERE (sqlc.narg('time_to')::text IS NULL OR
+                 (time_slot ->> 'startTime')::time < sqlc.narg('time_to')::text::time)
+            AND (sqlc.narg('time_from')::text IS NULL OR
+                 (time_slot ->> 'endTime')::time > sqlc.narg('time_from')::text::time)))))
+  AND (sqlc.narg('breed')::text IS NULL OR EXISTS
+    (SELECT 1
+     FROM sos_posts_pets
+              INNER JOIN pets ON sos_posts_pets.pet_id = pets.id
+     WHERE sos_posts_pets.sos_post_id = v_sos_posts.id
+       AND sos_posts_pets.deleted_at IS NULL
+       AND pets.breed = sqlc.narg('breed')::text))
+  -- 검색어가 주어지면 제목 또는 내용에 검색어를 포함하는 게시글만 조회한다.
+  AND (sqlc.narg('keyword')::text IS NULL OR v_sos_posts.id IN
+    (SELECT sos_posts.id
+     FROM sos_posts
+     WHERE sos_posts.title ILIKE '%' || sqlc.narg('keyword')::text || '%'
+        OR sos_posts.content ILIKE '%' || sqlc.narg('keyword')::text || '%'))
+  -- 커서가 주어지면 정렬 기준에 따라 커서 다음 게시글부터 조회한다.
+  AND (sqlc.narg('cursor_id')::uuid IS NULL OR
+       (sqlc.narg('sort_by') = 'newest' AND
+        (v_sos_posts.created_at, v_sos_posts.id) <
+        (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::uuid)) OR
+       -- 돌봄 시작 시각이 없는 게시글은 마지막에 정렬되므로 시작 시각이 있는 커서 다음에 온다.
+       (sqlc.narg('sort_by') = 'deadline' AND
+        (v_sos_posts.earliest_start_at > sqlc.narg('cursor_start_at')::timestamptz OR
+         (v_sos_posts.earliest_start_at IS NULL AND sqlc.narg('cursor_start_at')::timestamptz IS NOT NULL) OR
+         (v_sos_posts.earliest_start_at IS NOT DISTINCT FROM sqlc.narg('cursor_start_at')::timestamptz AND
+          v_sos_posts.id < sqlc.narg('cursor_id')::uuid))))
 ORDER BY CASE WHEN sqlc.narg('sort_by') = 'newest' THEN v_sos_posts.created_at END DESC,
-         CASE WHEN sqlc.narg('sort_by') = 'deadline' THEN v_sos_posts.earliest_date_start_at END
+         CASE WHEN sqlc.narg('sort_by') = 'deadline' THEN v_sos_posts.earliest_start_at END NULLS LAST,
+         CASE
+             WHEN sqlc.narg('sort_by') = 'nearest' THEN distance_km(
+                 sqlc.narg('latitude')::float8, sqlc.narg('longitude')::float8,
+                 v_sos_posts.latitude, v_sos_posts.longitude
+             ) END,
+         -- 제목에서 일치하는 게시글을 내용에서 일치하는 게시글보다 앞에 둔다.
+         CASE
+             WHEN sqlc.narg('sort_by') = 'relevance' THEN
+                 word_similarity(sqlc.narg('keyword')::text, v_sos_posts.title) * 2 +
+                 word_similarity(sqlc.narg('keyword')::text, v_sos_posts.content) END DESC,
+         -- 지원과 저장은 조회보다 관심이 크다고 보고 가중치를 둔다.
+         CASE
+             WHEN sqlc.narg('sort_by') = 'popular' THEN
+                 v_sos_posts.view_count + v_sos_posts.application_count * 5 + v_sos_posts.bookmark_count * 3 END DESC,
+         v_sos_posts.id DESC
 LIMIT sqlc.narg('limit') OFFSET sqlc.narg('offset');
 
 
@@ -97,12 +208,22 @@ SELECT v_sos_posts.id,
        v_sos_posts.content,
        v_sos_posts.reward,
        v_sos_posts.reward_type,
+       v_sos_posts.reward_amount,
+       v_sos_posts.reward_unit,
+       v_sos_posts.gifticon_description,
        v_sos_posts.care_type,
        v_sos_posts.carer_gender,
        v_sos_posts.thumbnail_id,
        v_sos_posts.author_id,
        v_sos_posts.created_at,
        v_sos_posts.updated_at,
+       v_sos_posts.status,
+       v_sos_posts.region,
+       v_sos_posts.latitude,
+       v_sos_posts.longitude,
+       v_sos_posts.view_count,
+       v_sos_posts.application_count,
+       v_sos_posts.bookmark_count,
        v_sos_posts.dates,
        v_pets_for_sos_posts.pets_info,
        v_media_for_sos_posts.media_info,
@@ -117,8 +238,13 @@ WHERE v_sos_posts.earliest_date_start_at >= sqlc.narg('earliest_date_start_at')
     (SELECT 1
      FROM unnest(pet_type_list) AS pet_type
      WHERE pet_type <> sqlc.narg('pet_type')))
+  AND (sqlc.narg('status')::text IS NULL OR v_sos_posts.status = sqlc.narg('status')::text)
 ORDER BY CASE WHEN sqlc.narg('sort_by') = 'newest' THEN v_sos_posts.created_at END DESC,
-         CASE WHEN sqlc.narg('sort_by') = 'deadline' THEN v_sos_posts.earliest_date_start_at END
+         CASE WHEN sqlc.narg('sort_by') = 'deadline' THEN v_sos_posts.earliest_start_at END NULLS LAST,
+         CASE
+             WHEN sqlc.narg('sort_by') = 'popular' THEN
+                 v_sos_posts.view_count + v_sos_posts.application_count * 5 + v_sos_posts.bookmark_count * 3 END DESC,
+         v_sos_posts.id DESC
 LIMIT sqlc.narg('limit') OFFSET sqlc.narg('offset');
 
 -- name: FindSOSPostByID :one
@@ -127,12 +253,22 @@ SELECT v_sos_posts.id,
        v_sos_posts.content,
        v_sos_posts.reward,
        v_sos_posts.reward_type,
+       v_sos_posts.reward_amount,
+       v_sos_posts.reward_unit,
+       v_sos_posts.gifticon_description,
        v_sos_posts.care_type,
        v_sos_posts.carer_gender,
        v_sos_posts.thumbnail_id,
        v_sos_posts.author_id,
        v_sos_posts.created_at,
        v_sos_posts.updated_at,
+       v_sos_posts.status,
+       v_sos_posts.region,
+       v_sos_posts.latitude,
+       v_sos_posts.longitude,
+       v_sos_posts.view_count,
+       v_sos_posts.application_count,
+       v_sos_posts.bookmark_count,
        v_sos_posts.dates,
        v_pets_for_sos_posts.pets_info,
        v_media_for_sos_posts.media_info,
@@ -147,6 +283,8 @@ WHERE v_sos_posts.id = sqlc.narg('id');
 SELECT sos_dates.id,
        sos_dates.date_start_at,
        sos_dates.date_end_at,
+       sos_dates.time_slots,
+       sos_dates.timezone,
        sos_dates.created_at,
        sos_dates.updated_at
 FROM sos_dates
@@ -159,17 +297,57 @@ WHERE sos_posts_dates.sos_post_id = sqlc.narg('id')
 -- name: UpdateSOSPost :one
 UPDATE
     sos_posts
-SET title        = $1,
-    content      = $2,
-    reward       = $3,
-    care_type    = $4,
-    carer_gender = $5,
-    reward_type  = $6,
-    thumbnail_id = $7,
-    updated_at   = NOW()
-WHERE id = $8
+SET title                = $1,
+    content              = $2,
+    reward               = $3,
+    care_type            = $4,
+    carer_gender         = $5,
+    reward_type          = $6,
+    thumbnail_id         = $7,
+    region               = $8,
+    latitude             = $9,
+    longitude            = $10,
+    reward_amount        = $11,
+    reward_unit          = $12,
+    gifticon_description = $13,
+    updated_at           = NOW()
+WHERE id = $14
 RETURNING
-    id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at;
+    id, author_id, title, content, reward, care_type, carer_gender, reward_type, thumbnail_id, created_at, updated_at,
+    status, region, latitude, longitude, reward_amount, reward_unit, gifticon_description;
+
+-- name: UpdateSOSPostStatus :one
+UPDATE
+    sos_posts
+SET status     = sqlc.arg('status'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+  -- 다른 요청이 먼저 상태를 바꾼 경우에는 변경하지 않는다.
+  AND status = sqlc.arg('current_status')
+  AND deleted_at IS NULL
+RETURNING id, status, updated_at;
+
+-- name: IncreaseSOSPostViewCount :exec
+WITH inserted AS (
+    -- 같은 조회자가 같은 날 다시 조회한 경우에는 기록하지 않으므로 조회수도 오르지 않는다.
+    INSERT INTO sos_post_views
+        (sos_post_id,
+         viewer_key,
+         viewed_on,
+         created_at)
+        VALUES (sqlc.arg('sos_post_id'), sqlc.arg('viewer_key'), CURRENT_DATE, NOW())
+        ON CONFLICT (sos_post_id, viewer_key, viewed_on) DO NOTHING
+        RETURNING sos_post_id)
+UPDATE sos_posts
+SET view_count = view_count + 1
+WHERE id IN (SELECT sos_post_id FROM inserted);
+
+-- name: DeleteSOSPost :exec
+UPDATE
+    sos_posts
+SET deleted_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL;
 
 -- name: DeleteSOSPostDateBySOSPostID :exec
 UPDATE
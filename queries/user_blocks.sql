@@ -0,0 +1,56 @@
+-- name: BlockUser :exec
+INSERT INTO user_blocks
+(id,
+ blocker_id,
+ blocked_id,
+ created_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (blocker_id, blocked_id) DO NOTHING;
+
+-- name: UnblockUser :exec
+DELETE
+FROM user_blocks
+WHERE blocker_id = $1
+  AND blocked_id = $2;
+
+-- name: FindBlockedUsers :many
+SELECT users.id,
+       users.nickname,
+       media.url AS profile_image_url,
+       user_blocks.created_at AS blocked_at
+FROM user_blocks
+         INNER JOIN users ON user_blocks.blocked_id = users.id
+         LEFT OUTER JOIN media ON users.profile_image_id = media.id
+WHERE user_blocks.blocker_id = $1
+ORDER BY user_blocks.created_at DESC;
+
+-- name: ExistsBlockBetween :one
+SELECT EXISTS (SELECT 1
+               FROM user_blocks
+               WHERE (blocker_id = sqlc.arg('user_id') AND blocked_id = sqlc.arg('other_user_id'))
+                  OR (blocker_id = sqlc.arg('other_user_id') AND blocked_id = sqlc.arg('user_id'))
+    );
+
+-- name: ExistsBlockInDirectRoom :one
+SELECT EXISTS (SELECT 1
+               FROM chat_rooms
+                        INNER JOIN user_chat_rooms
+                                   ON chat_rooms.id = user_chat_rooms.room_id
+                                       AND user_chat_rooms.user_id <> sqlc.arg('user_id')
+                        INNER JOIN user_blocks
+                                   ON (user_blocks.blocker_id = user_chat_rooms.user_id
+                                       AND user_blocks.blocked_id = sqlc.arg('user_id'))
+                                       OR (user_blocks.blocker_id = sqlc.arg('user_id')
+                                           AND user_blocks.blocked_id = user_chat_rooms.user_id)
+               WHERE chat_rooms.id = sqlc.arg('room_id')
+                 AND chat_rooms.room_type = 'direct'
+    );
+
+-- name: FindBlockRelatedUserIDs :many
+SELECT blocked_id AS user_id
+FROM user_blocks
+WHERE blocker_id = sqlc.arg('user_id')
+UNION
+SELECT blocker_id
+FROM user_blocks
+WHERE blocked_id = sqlc.arg('user_id');
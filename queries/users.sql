@@ -30,6 +30,17 @@ WHERE (users.id = sqlc.narg('id') OR sqlc.narg('id') IS NULL)
 ORDER BY users.created_at DESC
 LIMIT $1 OFFSET $2;
 
+-- name: FindUsersByIDs :many
+SELECT users.id,
+       users.nickname,
+       media.url AS profile_image_url
+FROM users
+         LEFT OUTER JOIN
+     media
+     ON
+         users.profile_image_id = media.id
+WHERE users.id = ANY (sqlc.arg('ids')::uuid[]);
+
 -- name: FindUser :one
 SELECT users.id,
        users.email,
@@ -40,7 +51,10 @@ SELECT users.id,
        users.fb_uid,
        users.created_at,
        users.updated_at,
-       users.deleted_at
+       users.deleted_at,
+       users.region,
+       users.latitude,
+       users.longitude
 FROM users
          LEFT OUTER JOIN
      media
@@ -83,6 +97,16 @@ RETURNING
     created_at,
     updated_at;
 
+-- name: UpdateUserLocationByFbUID :exec
+UPDATE
+    users
+SET region     = $1,
+    latitude   = $2,
+    longitude  = $3,
+    updated_at = NOW()
+WHERE fb_uid = $4
+  AND deleted_at IS NULL;
+
 -- name: DeleteUserByFbUID :exec
 UPDATE
     users